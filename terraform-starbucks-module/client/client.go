@@ -6,6 +6,7 @@ import (
     "fmt"
     "io"
     "net/http"
+    "net/url"
     "time"
 )
 
@@ -14,13 +15,21 @@ type StarbucksClient struct {
     Endpoint   string
     Region     string
     HTTPClient *http.Client
+
+    // OnDestroy selects what destroying a store or employee does:
+    // delete, archive or abandon.
+    OnDestroy string
+
+    // RedactPII keeps employee personal data out of state and logs.
+    RedactPII bool
 }
 
 func NewStarbucksClient(apiKey, endpoint, region string, timeout int64) *StarbucksClient {
     return &StarbucksClient{
-        APIKey:   apiKey,
-        Endpoint: endpoint,
-        Region:   region,
+        APIKey:    apiKey,
+        Endpoint:  endpoint,
+        Region:    region,
+        OnDestroy: "delete",
         HTTPClient: &http.Client{
             Timeout: time.Duration(timeout) * time.Second,
         },
@@ -63,3 +72,47 @@ func (c *StarbucksClient) DoRequest(method, path string, body interface{}) ([]by
 
     return respBody, nil
 }
+
+// ListAll fetches every item from a list endpoint. Endpoints may return either
+// a plain JSON array or a page envelope of the form
+// {"items": [...], "next_page_token": "..."}, in which case pages are followed
+// until no token is returned.
+func (c *StarbucksClient) ListAll(path string) ([]map[string]interface{}, error) {
+    var items []map[string]interface{}
+    pageToken := ""
+
+    for {
+        pagePath := path
+        if pageToken != "" {
+            sep := "?"
+            if u, err := url.Parse(path); err == nil && u.RawQuery != "" {
+                sep = "&"
+            }
+            pagePath = path + sep + "page_token=" + url.QueryEscape(pageToken)
+        }
+
+        respBody, err := c.DoRequest("GET", pagePath, nil)
+        if err != nil {
+            return nil, err
+        }
+
+        var page []map[string]interface{}
+        if err := json.Unmarshal(respBody, &page); err == nil {
+            return append(items, page...), nil
+        }
+
+        var envelope struct {
+            Items         []map[string]interface{} `json:"items"`
+            NextPageToken string                   `json:"next_page_token"`
+        }
+        if err := json.Unmarshal(respBody, &envelope); err != nil {
+            return nil, fmt.Errorf("error parsing list response: %w", err)
+        }
+        items = append(items, envelope.Items...)
+
+        if envelope.NextPageToken == "" {
+            return items, nil
+        }
+        pageToken = envelope.NextPageToken
+    }
+}
@@ -0,0 +1,82 @@
+package main
+
+import "net/url"
+
+// InventoryFilter narrows ListInventory. Empty fields are not filtered on.
+type InventoryFilter struct {
+    StoreID   string
+    ItemSKU   string
+    SKUPrefix string
+}
+
+// ListInventory returns every inventory item matching filter. All inventory
+// reads go through this endpoint so that every caller sees the same stock.
+func (c *StarbucksClient) ListInventory(filter InventoryFilter) ([]map[string]interface{}, error) {
+    query := url.Values{}
+    if filter.StoreID != "" {
+        query.Set("store_id", filter.StoreID)
+    }
+    if filter.ItemSKU != "" {
+        query.Set("item_sku", filter.ItemSKU)
+    }
+    if filter.SKUPrefix != "" {
+        query.Set("sku_prefix", filter.SKUPrefix)
+    }
+    if len(query) == 0 {
+        return c.ListAll("/inventory")
+    }
+    return c.ListAll("/inventory?" + query.Encode())
+}
+
+func storeInventoryPath(storeID string) string {
+    return "/stores/" + storeID + "/inventory"
+}
+
+// BatchUpdateStoreInventory creates or updates the upserted items and removes
+// the deleted SKUs of a store in a single call.
+func (c *StarbucksClient) BatchUpdateStoreInventory(storeID string, upserts []map[string]interface{}, deletes []string) error {
+    if len(upserts) == 0 && len(deletes) == 0 {
+        return nil
+    }
+    if upserts == nil {
+        upserts = []map[string]interface{}{}
+    }
+    if deletes == nil {
+        deletes = []string{}
+    }
+    body := map[string]interface{}{
+        "upsert": upserts,
+        "delete": deletes,
+    }
+    _, err := c.DoRequest("POST", storeInventoryPath(storeID)+"/batch", body)
+    return err
+}
+
+func inventoryTransfersPath(transferID string) string {
+    return "/inventory_transfers/" + transferID
+}
+
+// CreateInventoryTransfer moves stock between two stores. The API applies
+// all lines of a transfer atomically.
+func (c *StarbucksClient) CreateInventoryTransfer(transfer map[string]interface{}) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("POST", "/inventory_transfers", transfer)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// GetInventoryTransfer returns a transfer with its current status.
+func (c *StarbucksClient) GetInventoryTransfer(transferID string) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("GET", inventoryTransfersPath(transferID), nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// CancelInventoryTransfer cancels a transfer that has not completed yet.
+func (c *StarbucksClient) CancelInventoryTransfer(transferID string) error {
+    _, err := c.DoRequest("POST", inventoryTransfersPath(transferID)+"/cancel", nil)
+    return err
+}
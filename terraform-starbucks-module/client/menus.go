@@ -0,0 +1,46 @@
+package main
+
+func menusPath(menuID string) string {
+    return "/menus/" + menuID
+}
+
+// CreateMenu creates an unpublished draft menu and returns it.
+func (c *StarbucksClient) CreateMenu(menu map[string]interface{}) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("POST", "/menus", menu)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// GetMenu returns a menu with the contents of its current published version.
+func (c *StarbucksClient) GetMenu(menuID string) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("GET", menusPath(menuID), nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// UpdateMenuDraft replaces the draft of a menu. The published version is not
+// affected until PublishMenu is called.
+func (c *StarbucksClient) UpdateMenuDraft(menuID string, menu map[string]interface{}) error {
+    _, err := c.DoRequest("PUT", menusPath(menuID)+"/draft", menu)
+    return err
+}
+
+// PublishMenu publishes a menu's draft as a new version. The previously
+// published version is retained so it can be rolled back to.
+func (c *StarbucksClient) PublishMenu(menuID string) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("POST", menusPath(menuID)+"/publish", nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// DeleteMenu removes a menu and all of its versions.
+func (c *StarbucksClient) DeleteMenu(menuID string) error {
+    _, err := c.DoRequest("DELETE", menusPath(menuID), nil)
+    return err
+}
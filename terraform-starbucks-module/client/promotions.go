@@ -0,0 +1,72 @@
+package main
+
+import "fmt"
+
+func promoCodePoolsPath(promotionID string) string {
+    return "/promotions/" + promotionID + "/code_pools"
+}
+
+// CreatePromoCodePool creates an empty pool of codes for a promotion. Codes
+// are added to the pool with GeneratePromoCodes.
+func (c *StarbucksClient) CreatePromoCodePool(promotionID string, pool map[string]interface{}) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("POST", promoCodePoolsPath(promotionID), pool)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// GetPromoCodePool returns a pool's settings without its codes.
+func (c *StarbucksClient) GetPromoCodePool(promotionID, poolID string) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("GET", promoCodePoolsPath(promotionID)+"/"+poolID, nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// GeneratePromoCodes adds count new single-use codes to a pool and returns
+// them. Codes already in the pool are never regenerated.
+func (c *StarbucksClient) GeneratePromoCodes(promotionID, poolID string, count int64) ([]string, error) {
+    respBody, err := c.DoRequest("POST", promoCodePoolsPath(promotionID)+"/"+poolID+"/codes", map[string]interface{}{"count": count})
+    if err != nil {
+        return nil, err
+    }
+    result, err := decodeObject(respBody)
+    if err != nil {
+        return nil, err
+    }
+    raw, _ := result["codes"].([]interface{})
+    codes := make([]string, 0, len(raw))
+    for _, item := range raw {
+        if code, ok := item.(string); ok {
+            codes = append(codes, code)
+        }
+    }
+    if int64(len(codes)) != count {
+        return codes, fmt.Errorf("requested %d codes, received %d", count, len(codes))
+    }
+    return codes, nil
+}
+
+// ListPromoCodes returns every code in a pool in the order they were
+// generated.
+func (c *StarbucksClient) ListPromoCodes(promotionID, poolID string) ([]string, error) {
+    items, err := c.ListAll(promoCodePoolsPath(promotionID) + "/" + poolID + "/codes")
+    if err != nil {
+        return nil, err
+    }
+    codes := make([]string, 0, len(items))
+    for _, item := range items {
+        if code, ok := item["code"].(string); ok {
+            codes = append(codes, code)
+        }
+    }
+    return codes, nil
+}
+
+// DeletePromoCodePool removes a pool. Its unredeemed codes stop working.
+func (c *StarbucksClient) DeletePromoCodePool(promotionID, poolID string) error {
+    _, err := c.DoRequest("DELETE", promoCodePoolsPath(promotionID)+"/"+poolID, nil)
+    return err
+}
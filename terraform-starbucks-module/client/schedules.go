@@ -0,0 +1,49 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+)
+
+func schedulesPath(storeID string) string {
+    return "/stores/" + storeID + "/schedules"
+}
+
+// CreateSchedule creates a weekly shift schedule for a store and returns the
+// created schedule.
+func (c *StarbucksClient) CreateSchedule(storeID string, schedule map[string]interface{}) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("POST", schedulesPath(storeID), schedule)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// GetSchedule returns a store's weekly shift schedule.
+func (c *StarbucksClient) GetSchedule(storeID, scheduleID string) (map[string]interface{}, error) {
+    respBody, err := c.DoRequest("GET", schedulesPath(storeID)+"/"+scheduleID, nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// UpdateSchedule replaces the shifts and settings of a weekly schedule.
+func (c *StarbucksClient) UpdateSchedule(storeID, scheduleID string, schedule map[string]interface{}) error {
+    _, err := c.DoRequest("PUT", schedulesPath(storeID)+"/"+scheduleID, schedule)
+    return err
+}
+
+// DeleteSchedule removes a weekly schedule and all of its shifts.
+func (c *StarbucksClient) DeleteSchedule(storeID, scheduleID string) error {
+    _, err := c.DoRequest("DELETE", schedulesPath(storeID)+"/"+scheduleID, nil)
+    return err
+}
+
+func decodeObject(respBody []byte) (map[string]interface{}, error) {
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        return nil, fmt.Errorf("error parsing response: %w", err)
+    }
+    return result, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type certificationsDataSource struct { client *StarbucksClient }
+
+type certificationsDataSourceModel struct {
+    EmployeeID     types.String               `tfsdk:"employee_id"`
+    Certifications []certificationSummaryModel `tfsdk:"certifications"`
+}
+
+type certificationSummaryModel struct {
+    ID                types.String `tfsdk:"id"`
+    CertificationType types.String `tfsdk:"certification_type"`
+    IssuedDate        types.String `tfsdk:"issued_date"`
+    ExpiryDate        types.String `tfsdk:"expiry_date"`
+    Status            types.String `tfsdk:"status"`
+}
+
+func NewCertificationsDataSource() datasource.DataSource { return &certificationsDataSource{} }
+
+func (d *certificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_certifications"
+}
+
+func (d *certificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Lists the certifications held by an employee.",
+        Attributes: map[string]schema.Attribute{
+            "employee_id": schema.StringAttribute{Required: true},
+            "certifications": schema.ListNestedAttribute{
+                Computed: true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{Computed: true},
+                        "certification_type": schema.StringAttribute{Computed: true},
+                        "issued_date": schema.StringAttribute{Computed: true},
+                        "expiry_date": schema.StringAttribute{Computed: true},
+                        "status": schema.StringAttribute{Computed: true},
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (d *certificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *certificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state certificationsDataSourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    certifications, err := d.client.ListAll(employeeCertificationsPath(state.EmployeeID.ValueString()))
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list certifications: %s", err)); return }
+
+    state.Certifications = flattenCertifications(certifications, time.Now())
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func flattenCertifications(certifications []map[string]interface{}, now time.Time) []certificationSummaryModel {
+    result := []certificationSummaryModel{}
+    for _, c := range certifications {
+        cert := certificationSummaryModel{
+            ID:                types.StringNull(),
+            CertificationType: types.StringNull(),
+            IssuedDate:        types.StringNull(),
+            ExpiryDate:        types.StringNull(),
+        }
+        if v, ok := c["id"].(string); ok { cert.ID = types.StringValue(v) }
+        if v, ok := c["certification_type"].(string); ok { cert.CertificationType = types.StringValue(v) }
+        if v, ok := c["issued_date"].(string); ok { cert.IssuedDate = types.StringValue(v) }
+        if v, ok := c["expiry_date"].(string); ok { cert.ExpiryDate = types.StringValue(v) }
+        cert.Status = types.StringValue(certificationStatus(cert.ExpiryDate.ValueString(), now))
+        if v, ok := c["status"].(string); ok { cert.Status = types.StringValue(v) }
+        result = append(result, cert)
+    }
+    return result
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "math"
+    "sort"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type drinkCapacityDataSource struct { client *StarbucksClient }
+
+type drinkCapacityDataSourceModel struct {
+    StoreID types.String             `tfsdk:"store_id"`
+    Items   []drinkCapacityItemModel `tfsdk:"items"`
+}
+
+type drinkCapacityItemModel struct {
+    MenuItemID      types.String `tfsdk:"menu_item_id"`
+    Name            types.String `tfsdk:"name"`
+    Size            types.String `tfsdk:"size"`
+    Servings        types.Int64  `tfsdk:"servings"`
+    LimitingItemSKU types.String `tfsdk:"limiting_item_sku"`
+}
+
+func NewDrinkCapacityDataSource() datasource.DataSource { return &drinkCapacityDataSource{} }
+
+func (d *drinkCapacityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_drink_capacity"
+}
+
+func (d *drinkCapacityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Calculates how many servings of each menu item size a store's current inventory supports, using menu item recipes. Items are ordered by servings, so the items that will go unavailable first are listed first.",
+        Attributes: map[string]schema.Attribute{
+            "store_id": schema.StringAttribute{Required: true},
+            "items": schema.ListNestedAttribute{
+                Computed: true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "menu_item_id": schema.StringAttribute{Computed: true},
+                        "name": schema.StringAttribute{Computed: true},
+                        "size": schema.StringAttribute{Description: "Size, or null for items without sizes", Computed: true},
+                        "servings": schema.Int64Attribute{Description: "Servings the store's inventory supports", Computed: true},
+                        "limiting_item_sku": schema.StringAttribute{Description: "SKU that runs out first", Computed: true},
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (d *drinkCapacityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *drinkCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state drinkCapacityDataSourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    inventory, err := d.client.ListInventory(InventoryFilter{StoreID: state.StoreID.ValueString()})
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list inventory: %s", err)); return }
+    menuItems, err := d.client.ListAll("/menu_items")
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list menu items: %s", err)); return }
+
+    stock := map[string]inventoryStock{}
+    for _, item := range inventory {
+        sku, _ := item["item_sku"].(string)
+        quantity, _ := item["quantity"].(float64)
+        unit, _ := item["unit"].(string)
+        if unit == "" { unit = "count" }
+        held, ok := stock[sku]
+        if !ok { stock[sku] = inventoryStock{quantity: quantity, unit: unit}; continue }
+        if converted, err := convertUnits(quantity, unit, held.unit); err == nil {
+            held.quantity += converted
+            stock[sku] = held
+        }
+    }
+
+    state.Items = drinkCapacity(menuItems, stock)
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// inventoryStock is the quantity of a SKU a store holds, in unit.
+type inventoryStock struct {
+    quantity float64
+    unit     string
+}
+
+// drinkCapacity computes the servings of every menu item size with a recipe
+// that stock supports, ordered from fewest to most servings. Recipe quantities
+// are converted to the unit the SKU is stocked in; an ingredient whose unit
+// cannot be converted supports no servings.
+func drinkCapacity(menuItems []map[string]interface{}, stock map[string]inventoryStock) []drinkCapacityItemModel {
+    result := []drinkCapacityItemModel{}
+    for _, m := range menuItems {
+        recipe, _ := m["recipe"].([]interface{})
+        if len(recipe) == 0 { continue }
+
+        // Ingredients without a size are shared by every size.
+        shared := map[string]float64{}
+        bySize := map[string]map[string]float64{}
+        for _, e := range recipe {
+            entry, ok := e.(map[string]interface{})
+            if !ok { continue }
+            sku, _ := entry["item_sku"].(string)
+            quantity, _ := entry["quantity"].(float64)
+            if unit, _ := entry["unit"].(string); unit != "" && stock[sku].unit != "" {
+                converted, err := convertUnits(quantity, unit, stock[sku].unit)
+                if err != nil { converted = math.Inf(1) }
+                quantity = converted
+            }
+            size, _ := entry["size"].(string)
+            if size == "" { shared[sku] += quantity; continue }
+            if bySize[size] == nil { bySize[size] = map[string]float64{} }
+            bySize[size][sku] += quantity
+        }
+
+        var sizes []string
+        if variants, ok := m["variants"].([]interface{}); ok {
+            for _, v := range variants {
+                if variant, ok := v.(map[string]interface{}); ok {
+                    if size, ok := variant["size"].(string); ok { sizes = append(sizes, size) }
+                }
+            }
+        }
+        if size, ok := m["size"].(string); ok && len(sizes) == 0 { sizes = append(sizes, size) }
+        if len(sizes) == 0 { sizes = append(sizes, "") }
+
+        id, _ := m["id"].(string)
+        name, _ := m["name"].(string)
+        for _, size := range sizes {
+            needed := map[string]float64{}
+            for sku, quantity := range shared { needed[sku] += quantity }
+            for sku, quantity := range bySize[size] { needed[sku] += quantity }
+            if len(needed) == 0 { continue }
+
+            servings, limiting := math.MaxFloat64, ""
+            for sku, quantity := range needed {
+                if quantity <= 0 { continue }
+                if n := math.Floor(stock[sku].quantity / quantity); n < servings || (n == servings && sku < limiting) {
+                    servings, limiting = n, sku
+                }
+            }
+            if limiting == "" { continue }
+
+            item := drinkCapacityItemModel{
+                MenuItemID:      types.StringValue(id),
+                Name:            types.StringValue(name),
+                Size:            types.StringNull(),
+                Servings:        types.Int64Value(int64(math.Max(servings, 0))),
+                LimitingItemSKU: types.StringValue(limiting),
+            }
+            if size != "" { item.Size = types.StringValue(size) }
+            result = append(result, item)
+        }
+    }
+
+    sort.SliceStable(result, func(i, j int) bool {
+        if result[i].Servings.ValueInt64() != result[j].Servings.ValueInt64() {
+            return result[i].Servings.ValueInt64() < result[j].Servings.ValueInt64()
+        }
+        return result[i].Name.ValueString() < result[j].Name.ValueString()
+    })
+    return result
+}
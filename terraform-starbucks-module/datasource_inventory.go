@@ -0,0 +1,180 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type inventoryItemModel struct {
+    ID               types.String `tfsdk:"id"`
+    StoreID          types.String `tfsdk:"store_id"`
+    ItemSKU          types.String `tfsdk:"item_sku"`
+    ItemName         types.String `tfsdk:"item_name"`
+    ItemType         types.String `tfsdk:"item_type"`
+    Quantity         types.Int64  `tfsdk:"quantity"`
+    Unit             types.String `tfsdk:"unit"`
+    ReorderLevel     types.Int64  `tfsdk:"reorder_level"`
+    ReorderLevelUnit types.String `tfsdk:"reorder_level_unit"`
+    LastRestocked    types.String `tfsdk:"last_restocked"`
+    NeedsReorder     types.Bool   `tfsdk:"needs_reorder"`
+}
+
+// inventoryItemAttributes returns the computed attributes of an inventory
+// item, shared by the single item and list data sources.
+func inventoryItemAttributes() map[string]schema.Attribute {
+    return map[string]schema.Attribute{
+        "id": schema.StringAttribute{Computed: true},
+        "store_id": schema.StringAttribute{Computed: true},
+        "item_sku": schema.StringAttribute{Computed: true},
+        "item_name": schema.StringAttribute{Computed: true},
+        "item_type": schema.StringAttribute{Computed: true},
+        "quantity": schema.Int64Attribute{Description: "Quantity on hand, in unit", Computed: true},
+        "unit": schema.StringAttribute{Computed: true},
+        "reorder_level": schema.Int64Attribute{Description: "Quantity at or below which the item needs reordering, in reorder_level_unit", Computed: true},
+        "reorder_level_unit": schema.StringAttribute{Computed: true},
+        "last_restocked": schema.StringAttribute{Computed: true},
+        "needs_reorder": schema.BoolAttribute{Description: "Whether quantity is at or below reorder_level after converting units", Computed: true},
+    }
+}
+
+// flattenInventoryItem maps an inventory item returned by the API. needs_reorder
+// is worked out with needsReorder, as in the inventory resource.
+func flattenInventoryItem(item map[string]interface{}) inventoryItemModel {
+    m := inventoryItemModel{
+        ID:               types.StringNull(),
+        StoreID:          types.StringNull(),
+        ItemSKU:          types.StringNull(),
+        ItemName:         types.StringNull(),
+        ItemType:         types.StringNull(),
+        Quantity:         types.Int64Value(0),
+        Unit:             types.StringValue("count"),
+        ReorderLevel:     types.Int64Null(),
+        ReorderLevelUnit: types.StringNull(),
+        LastRestocked:    types.StringNull(),
+        NeedsReorder:     types.BoolValue(false),
+    }
+    if v, ok := item["id"].(string); ok { m.ID = types.StringValue(v) }
+    if v, ok := item["store_id"].(string); ok { m.StoreID = types.StringValue(v) }
+    if v, ok := item["item_sku"].(string); ok { m.ItemSKU = types.StringValue(v) }
+    if v, ok := item["item_name"].(string); ok { m.ItemName = types.StringValue(v) }
+    if v, ok := item["item_type"].(string); ok { m.ItemType = types.StringValue(v) }
+    if v, ok := item["quantity"].(float64); ok { m.Quantity = types.Int64Value(int64(v)) }
+    if v, ok := item["unit"].(string); ok && v != "" { m.Unit = types.StringValue(v) }
+    if v, ok := item["reorder_level"].(float64); ok { m.ReorderLevel = types.Int64Value(int64(v)) }
+    if v, ok := item["reorder_level_unit"].(string); ok && v != "" { m.ReorderLevelUnit = types.StringValue(v) }
+    if v, ok := item["last_restocked"].(string); ok { m.LastRestocked = types.StringValue(v) }
+
+    if !m.ReorderLevel.IsNull() {
+        m.NeedsReorder = types.BoolValue(needsReorder(m.Quantity.ValueInt64(), m.Unit.ValueString(), m.ReorderLevel.ValueInt64(), m.ReorderLevelUnit.ValueString()))
+    }
+    return m
+}
+
+type inventoryDataSource struct { client *StarbucksClient }
+
+func NewInventoryDataSource() datasource.DataSource { return &inventoryDataSource{} }
+
+func (d *inventoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+func (d *inventoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    attributes := inventoryItemAttributes()
+    attributes["store_id"] = schema.StringAttribute{Required: true}
+    attributes["item_sku"] = schema.StringAttribute{Required: true}
+    resp.Schema = schema.Schema{
+        Description: "Looks up the inventory a store holds of one SKU.",
+        Attributes:  attributes,
+    }
+}
+
+func (d *inventoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *inventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var config inventoryItemModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() { return }
+
+    storeID, sku := config.StoreID.ValueString(), config.ItemSKU.ValueString()
+    items, err := d.client.ListInventory(InventoryFilter{StoreID: storeID, ItemSKU: sku})
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list inventory: %s", err)); return }
+    for _, item := range items {
+        if item["store_id"] != storeID || item["item_sku"] != sku { continue }
+        state := flattenInventoryItem(item)
+        resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+        return
+    }
+    resp.Diagnostics.AddError("Inventory Not Found", fmt.Sprintf("Store %q does not stock SKU %q.", storeID, sku))
+}
+
+type inventoryItemsDataSource struct { client *StarbucksClient }
+
+type inventoryItemsDataSourceModel struct {
+    StoreID            types.String         `tfsdk:"store_id"`
+    SKUPrefix          types.String         `tfsdk:"sku_prefix"`
+    BelowThresholdOnly types.Bool           `tfsdk:"below_threshold_only"`
+    Items              []inventoryItemModel `tfsdk:"items"`
+}
+
+func NewInventoryItemsDataSource() datasource.DataSource { return &inventoryItemsDataSource{} }
+
+func (d *inventoryItemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_inventory_items"
+}
+
+func (d *inventoryItemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Lists inventory items, ordered by store and SKU. Combine below_threshold_only with an output to drive low-stock alerts.",
+        Attributes: map[string]schema.Attribute{
+            "store_id": schema.StringAttribute{Description: "Only list items held by this store", Optional: true},
+            "sku_prefix": schema.StringAttribute{Description: "Only list SKUs starting with this prefix, e.g. MILK-", Optional: true},
+            "below_threshold_only": schema.BoolAttribute{Description: "Only list items at or below their reorder level", Optional: true},
+            "items": schema.ListNestedAttribute{
+                Computed:     true,
+                NestedObject: schema.NestedAttributeObject{Attributes: inventoryItemAttributes()},
+            },
+        },
+    }
+}
+
+func (d *inventoryItemsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *inventoryItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state inventoryItemsDataSourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    items, err := d.client.ListInventory(InventoryFilter{StoreID: state.StoreID.ValueString(), SKUPrefix: state.SKUPrefix.ValueString()})
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list inventory: %s", err)); return }
+
+    state.Items = []inventoryItemModel{}
+    for _, item := range items {
+        m := flattenInventoryItem(item)
+        if !strings.HasPrefix(m.ItemSKU.ValueString(), state.SKUPrefix.ValueString()) { continue }
+        if state.BelowThresholdOnly.ValueBool() && !m.NeedsReorder.ValueBool() { continue }
+        state.Items = append(state.Items, m)
+    }
+    sort.SliceStable(state.Items, func(i, j int) bool {
+        if state.Items[i].StoreID.ValueString() != state.Items[j].StoreID.ValueString() {
+            return state.Items[i].StoreID.ValueString() < state.Items[j].StoreID.ValueString()
+        }
+        return state.Items[i].ItemSKU.ValueString() < state.Items[j].ItemSKU.ValueString()
+    })
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type storeEffectiveHoursDataSource struct { client *StarbucksClient }
+
+type storeEffectiveHoursDataSourceModel struct {
+    StoreID  types.String `tfsdk:"store_id"`
+    Date     types.String `tfsdk:"date"`
+    Closed   types.Bool   `tfsdk:"closed"`
+    Open     types.String `tfsdk:"open"`
+    Close    types.String `tfsdk:"close"`
+    Timezone types.String `tfsdk:"timezone"`
+    Source   types.String `tfsdk:"source"`
+    Reason   types.String `tfsdk:"reason"`
+}
+
+func NewStoreEffectiveHoursDataSource() datasource.DataSource { return &storeEffectiveHoursDataSource{} }
+
+func (d *storeEffectiveHoursDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_store_effective_hours"
+}
+
+func (d *storeEffectiveHoursDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Computes a store's effective opening hours on a given date, applying any hours exceptions over its weekly_hours.",
+        Attributes: map[string]schema.Attribute{
+            "store_id": schema.StringAttribute{Required: true},
+            "date": schema.StringAttribute{
+                Description: "Date to compute hours for (YYYY-MM-DD format)",
+                Required:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "closed": schema.BoolAttribute{Computed: true},
+            "open": schema.StringAttribute{Computed: true},
+            "close": schema.StringAttribute{Computed: true},
+            "timezone": schema.StringAttribute{Computed: true},
+            "source": schema.StringAttribute{
+                Description: "Where the hours came from: regular or exception",
+                Computed:    true,
+            },
+            "reason": schema.StringAttribute{Computed: true},
+        },
+    }
+}
+
+func (d *storeEffectiveHoursDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *storeEffectiveHoursDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state storeEffectiveHoursDataSourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    date, err := time.Parse(dateLayout, state.Date.ValueString())
+    if err != nil { resp.Diagnostics.AddError("Invalid Date", fmt.Sprintf("Unable to parse date: %s", err)); return }
+
+    respBody, err := d.client.DoRequest("GET", "/stores/"+state.StoreID.ValueString(), nil)
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store: %s", err)); return }
+    var store map[string]interface{}
+    if err := json.Unmarshal(respBody, &store); err != nil { resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err)); return }
+
+    exceptions, err := d.client.ListAll(storeHoursExceptionsPath(state.StoreID.ValueString()))
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list store hours exceptions: %s", err)); return }
+
+    state.Timezone = types.StringNull()
+    if v, ok := store["timezone"].(string); ok { state.Timezone = types.StringValue(v) }
+    state.Open = types.StringNull()
+    state.Close = types.StringNull()
+    state.Reason = types.StringNull()
+
+    if exception := findHoursException(date, exceptions); exception != nil {
+        state.Source = types.StringValue("exception")
+        closed, _ := exception["closed"].(bool)
+        state.Closed = types.BoolValue(closed)
+        if v, ok := exception["open"].(string); ok && !closed { state.Open = types.StringValue(normalizeClock(v)) }
+        if v, ok := exception["close"].(string); ok && !closed { state.Close = types.StringValue(normalizeClock(v)) }
+        if v, ok := exception["reason"].(string); ok { state.Reason = types.StringValue(v) }
+        resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+        return
+    }
+
+    state.Source = types.StringValue("regular")
+    weeklyHours, ok := store["weekly_hours"].(map[string]interface{})
+    if !ok {
+        resp.Diagnostics.AddWarning("Store Has No Weekly Hours",
+            fmt.Sprintf("Store %s has no weekly_hours, so its regular hours on %s cannot be computed.", state.StoreID.ValueString(), state.Date.ValueString()))
+        state.Closed = types.BoolValue(false)
+        resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+        return
+    }
+
+    day, ok := weeklyHours[strings.ToLower(date.Weekday().String())].(map[string]interface{})
+    state.Closed = types.BoolValue(!ok)
+    if ok {
+        if v, ok := day["open"].(string); ok { state.Open = types.StringValue(normalizeClock(v)) }
+        if v, ok := day["close"].(string); ok { state.Close = types.StringValue(normalizeClock(v)) }
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findHoursException returns the exception covering date. When several
+// overlap, the one starting latest wins as it is the most specific.
+func findHoursException(date time.Time, exceptions []map[string]interface{}) map[string]interface{} {
+    var match map[string]interface{}
+    var matchStart time.Time
+
+    for _, exception := range exceptions {
+        startStr, _ := exception["start_date"].(string)
+        endStr, _ := exception["end_date"].(string)
+        start, err := time.Parse(dateLayout, startStr)
+        if err != nil { continue }
+        end, err := time.Parse(dateLayout, endStr)
+        if err != nil { continue }
+
+        if date.Before(start) || date.After(end) { continue }
+        if match == nil || start.After(matchStart) {
+            match = exception
+            matchStart = start
+        }
+    }
+    return match
+}
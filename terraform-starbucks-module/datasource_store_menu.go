@@ -0,0 +1,129 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type storeMenuDataSource struct { client *StarbucksClient }
+
+type storeMenuDataSourceModel struct {
+    StoreID types.String         `tfsdk:"store_id"`
+    Date    types.String         `tfsdk:"date"`
+    Items   []storeMenuItemModel `tfsdk:"items"`
+}
+
+type storeMenuItemModel struct {
+    MenuItemID      types.String  `tfsdk:"menu_item_id"`
+    Name            types.String  `tfsdk:"name"`
+    Category        types.String  `tfsdk:"category"`
+    Price           types.Float64 `tfsdk:"price"`
+    PriceOverridden types.Bool    `tfsdk:"price_overridden"`
+}
+
+func NewStoreMenuDataSource() datasource.DataSource { return &storeMenuDataSource{} }
+
+func (d *storeMenuDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_store_menu"
+}
+
+func (d *storeMenuDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Computes the effective menu of a store on a given date, applying store menu item availability, price overrides and launch/retire dates over the chain-wide menu.",
+        Attributes: map[string]schema.Attribute{
+            "store_id": schema.StringAttribute{Required: true},
+            "date": schema.StringAttribute{
+                Description: "Date to compute the menu for (YYYY-MM-DD format). Defaults to today.",
+                Optional:    true,
+                Computed:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "items": schema.ListNestedAttribute{
+                Description: "Menu items on sale at the store on date",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "menu_item_id": schema.StringAttribute{Computed: true},
+                        "name": schema.StringAttribute{Computed: true},
+                        "category": schema.StringAttribute{Computed: true},
+                        "price": schema.Float64Attribute{Description: "Effective price at the store (USD)", Computed: true},
+                        "price_overridden": schema.BoolAttribute{Description: "Whether price comes from a store price override", Computed: true},
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (d *storeMenuDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+    if req.ProviderData == nil { return }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok { resp.Diagnostics.AddError("Unexpected DataSource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData)); return }
+    d.client = client
+}
+
+func (d *storeMenuDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state storeMenuDataSourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    if state.Date.IsNull() || state.Date.IsUnknown() { state.Date = types.StringValue(time.Now().Format(dateLayout)) }
+    date, err := time.Parse(dateLayout, state.Date.ValueString())
+    if err != nil { resp.Diagnostics.AddError("Invalid Date", fmt.Sprintf("Unable to parse date: %s", err)); return }
+
+    menuItems, err := d.client.ListAll("/menu_items")
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list menu items: %s", err)); return }
+    overrides, err := d.client.ListAll(storeMenuItemsPath(state.StoreID.ValueString()))
+    if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list store menu items: %s", err)); return }
+
+    state.Items = effectiveStoreMenu(menuItems, overrides, date)
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// effectiveStoreMenu applies a store's menu item overrides to the chain-wide
+// menu. Items the store has not overridden follow their global is_available.
+func effectiveStoreMenu(menuItems, overrides []map[string]interface{}, date time.Time) []storeMenuItemModel {
+    byItem := map[string]map[string]interface{}{}
+    for _, o := range overrides {
+        if id, ok := o["menu_item_id"].(string); ok { byItem[id] = o }
+    }
+
+    result := []storeMenuItemModel{}
+    for _, m := range menuItems {
+        id, _ := m["id"].(string)
+        available := true
+        if v, ok := m["is_available"].(bool); ok { available = v }
+        item := storeMenuItemModel{
+            MenuItemID:      types.StringValue(id),
+            Name:            types.StringNull(),
+            Category:        types.StringNull(),
+            Price:           types.Float64Null(),
+            PriceOverridden: types.BoolValue(false),
+        }
+        if v, ok := m["name"].(string); ok { item.Name = types.StringValue(v) }
+        if v, ok := m["category"].(string); ok { item.Category = types.StringValue(v) }
+        if v, ok := m["price"].(float64); ok { item.Price = types.Float64Value(v) }
+
+        if o, ok := byItem[id]; ok {
+            if v, ok := o["is_available"].(bool); ok { available = v }
+            if v, ok := o["launch_date"].(string); ok {
+                if launch, err := time.Parse(dateLayout, v); err == nil && date.Before(launch) { available = false }
+            }
+            if v, ok := o["retire_date"].(string); ok {
+                if retire, err := time.Parse(dateLayout, v); err == nil && !date.Before(retire) { available = false }
+            }
+            if v, ok := o["price_override"].(float64); ok {
+                item.Price = types.Float64Value(v)
+                item.PriceOverridden = types.BoolValue(true)
+            }
+        }
+        if available { result = append(result, item) }
+    }
+    return result
+}
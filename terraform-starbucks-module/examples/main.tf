@@ -34,6 +34,7 @@ resource "starbucks_store" "flagship_stores" {
       has_drive_thru = false
       store_type   = "reserve"
       capacity     = 150
+      timezone     = "America/Los_Angeles"
     }
     new_york = {
       name         = "New York Reserve Roastery"
@@ -47,6 +48,7 @@ resource "starbucks_store" "flagship_stores" {
       has_drive_thru = false
       store_type   = "reserve"
       capacity     = 200
+      timezone     = "America/New_York"
     }
   }
 
@@ -62,7 +64,11 @@ resource "starbucks_store" "flagship_stores" {
   latitude       = each.value.latitude
   longitude      = each.value.longitude
   
-  opening_hours  = "Mon-Sun: 6AM-10PM"
+  weekly_hours = {
+    for day in ["monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"] :
+    day => { open = "06:00", close = "22:00" }
+  }
+  timezone       = each.value.timezone
   
   has_drive_thru  = each.value.has_drive_thru
   has_wifi        = true
@@ -73,6 +79,15 @@ resource "starbucks_store" "flagship_stores" {
   manager_email  = "manager.${each.key}@starbucks.com"
 }
 
+# Close the Seattle store for Thanksgiving
+resource "starbucks_store_hours_exception" "seattle_thanksgiving" {
+  store_id   = starbucks_store.flagship_stores["seattle"].id
+  start_date = "2024-11-28"
+  end_date   = "2024-11-28"
+  closed     = true
+  reason     = "Thanksgiving"
+}
+
 # Create employees for Seattle store
 resource "starbucks_employee" "seattle_team" {
   for_each = {
@@ -125,6 +140,16 @@ resource "starbucks_employee" "seattle_team" {
   employment_type = "full_time"
 }
 
+# Track food safety certifications for the Seattle team
+resource "starbucks_employee_certification" "seattle_food_safety" {
+  for_each = starbucks_employee.seattle_team
+
+  employee_id        = each.value.id
+  certification_type = "food_safety"
+  issued_date        = "2024-01-15"
+  expiry_date        = "2027-01-15"
+}
+
 # Create menu items
 resource "starbucks_menu_item" "signature_drinks" {
   for_each = {
@@ -133,31 +158,108 @@ resource "starbucks_menu_item" "signature_drinks" {
       price    = 5.95
       calories = 380
       seasonal = true
+      allergens = ["milk"]
     }
     caramel_macchiato = {
       name     = "Caramel Macchiato"
       price    = 5.45
       calories = 250
       seasonal = false
+      allergens = ["milk", "soy"]
     }
     cold_brew = {
       name     = "Cold Brew"
       price    = 4.95
       calories = 5
       seasonal = false
+      allergens = []
     }
   }
 
   name        = each.value.name
   category    = "coffee"
-  size        = "grande"
-  price       = each.value.price
-  calories    = each.value.calories
+
+  variants = [
+    { size = "tall", price = each.value.price - 0.50 },
+    { size = "grande", price = each.value.price },
+    { size = "venti", price = each.value.price + 0.50 },
+  ]
+
+  nutrition = {
+    calories = each.value.calories
+  }
+  allergens = each.value.allergens
   
   is_available = true
   is_seasonal  = each.value.seasonal
 }
 
+# Sell the PSL in Seattle at a local price for the autumn season only
+resource "starbucks_store_menu_item" "seattle_psl" {
+  store_id       = starbucks_store.flagship_stores["seattle"].id
+  menu_item_id   = starbucks_menu_item.signature_drinks["psl"].id
+  price_override = 6.25
+  launch_date    = "2024-08-22"
+  retire_date    = "2024-12-01"
+}
+
+# Offer milk alternatives on the espresso drinks
+resource "starbucks_menu_customization" "milk" {
+  name = "Milk"
+  choices = [
+    { name = "2% Milk" },
+    { name = "Whole Milk" },
+    { name = "Oat Milk", upcharge = 0.70 },
+    { name = "Almond Milk", upcharge = 0.70 },
+  ]
+  default_choices = ["2% Milk"]
+  min_selections  = 1
+  max_selections  = 1
+  menu_item_ids = [
+    starbucks_menu_item.signature_drinks["psl"].id,
+    starbucks_menu_item.signature_drinks["caramel_macchiato"].id,
+  ]
+}
+
+# Group the signature drinks into a seasonal menu for Washington
+resource "starbucks_menu" "fall" {
+  name           = "Fall 2024"
+  effective_from = "2024-08-22"
+  effective_to   = "2024-11-30"
+  menu_item_ids  = [for item in starbucks_menu_item.signature_drinks : item.id]
+  category_order = ["coffee", "tea", "bakery"]
+  regions        = ["us-west"]
+}
+
+# Suppliers and the SKU catalog that inventory refers to
+resource "starbucks_supplier" "roastery" {
+  name          = "Kent Roastery"
+  contact_name  = "Dana Lee"
+  contact_email = "orders@kent-roastery.example.com"
+}
+
+resource "starbucks_supplier" "dairy" {
+  name          = "Cascade Dairy"
+  contact_email = "wholesale@cascade-dairy.example.com"
+}
+
+resource "starbucks_product" "catalog" {
+  for_each = {
+    beans    = { sku = "BEAN-PIKE-1LB", name = "Pike Place Roast", category = "beans", unit = "lbs", cost = 7.50, supplier = "roastery", shelf_life = 90 }
+    milk     = { sku = "MILK-WHOLE-GAL", name = "Whole Milk", category = "milk", unit = "gallons", cost = 4.20, supplier = "dairy", shelf_life = 14 }
+    oat_milk = { sku = "MILK-OAT-64OZ", name = "Oat Milk", category = "milk", unit = "count", cost = 3.10, supplier = "dairy", shelf_life = 30 }
+    cups     = { sku = "CUP-HOT-16OZ", name = "Grande Cups", category = "packaging", unit = "count", cost = 0.06, supplier = "roastery", shelf_life = null }
+  }
+
+  sku             = each.value.sku
+  name            = each.value.name
+  category        = each.value.category
+  unit            = each.value.unit
+  unit_cost       = each.value.cost
+  supplier_id     = each.value.supplier == "roastery" ? starbucks_supplier.roastery.id : starbucks_supplier.dairy.id
+  shelf_life_days = each.value.shelf_life
+}
+
 # Create inventory for Seattle store
 resource "starbucks_inventory" "seattle_inventory" {
   for_each = {
@@ -185,6 +287,7 @@ resource "starbucks_inventory" "seattle_inventory" {
   }
 
   store_id       = starbucks_store.flagship_stores["seattle"].id
+  item_sku       = starbucks_product.catalog[each.key].sku
   item_name      = each.value.item
   item_type      = each.value.type
   quantity       = each.value.quantity
@@ -193,6 +296,69 @@ resource "starbucks_inventory" "seattle_inventory" {
   last_restocked = "2024-10-01"
 }
 
+# Manage the New York store's pantry in one resource
+resource "starbucks_store_inventory" "new_york" {
+  store_id = starbucks_store.flagship_stores["new_york"].id
+
+  items = {
+    "BEAN-PIKE-1LB"  = { quantity = 80, unit = "lbs", reorder_level = 15 }
+    "MILK-WHOLE-GAL" = { quantity = 40, unit = "gallons", reorder_level = 8 }
+    "CUP-HOT-16OZ"   = { quantity = 4000, reorder_level = 800 }
+  }
+}
+
+# Move surplus beans from New York to Seattle
+resource "starbucks_inventory_transfer" "beans_to_seattle" {
+  source_store_id      = starbucks_store_inventory.new_york.store_id
+  destination_store_id = starbucks_store.flagship_stores["seattle"].id
+  reason               = "Seattle running low before the weekend"
+
+  lines = [
+    { item_sku = "BEAN-PIKE-1LB", quantity = 20, unit = "lbs" },
+  ]
+}
+
+# Reorder beans for every West Coast store when stock runs low
+resource "starbucks_reorder_rule" "pike_place_west" {
+  item_sku         = "BEAN-PIKE-1LB"
+  region           = "us-west"
+  reorder_point    = 20
+  reorder_quantity = 100
+  unit             = "lbs"
+  supplier_id      = starbucks_supplier.roastery.id
+  lead_time_days   = 3
+}
+
+# One-off order for the Seattle holiday launch
+resource "starbucks_purchase_order" "seattle_holiday" {
+  store_id               = starbucks_store.flagship_stores["seattle"].id
+  supplier_id            = starbucks_supplier.roastery.id
+  expected_delivery_date = "2024-11-01"
+  status                 = "submitted"
+
+  line_items = [
+    { item_sku = "BEAN-HOLIDAY-1LB", quantity = 50, unit = "lbs", unit_cost = 9.75 },
+    { item_sku = "CUP-HOLIDAY-16OZ", quantity = 5000, unit_cost = 0.08 },
+  ]
+}
+
+# Track oat milk through deliveries and waste so POS sales are never reset
+resource "starbucks_inventory" "seattle_oat_milk" {
+  store_id      = starbucks_store.flagship_stores["seattle"].id
+  item_sku      = starbucks_product.catalog["oat_milk"].sku
+  item_name     = "Oat Milk"
+  item_type     = "milk"
+  quantity      = 24
+  unit          = "count"
+  reorder_level = 6
+  quantity_mode = "adjustment"
+
+  adjustments = [
+    { type = "receive", quantity = 48, reason = "Weekly delivery" },
+    { type = "waste", quantity = 2, reason = "Expired" },
+  ]
+}
+
 # Create promotions
 resource "starbucks_promotion" "seasonal_promos" {
   for_each = {
@@ -200,28 +366,58 @@ resource "starbucks_promotion" "seasonal_promos" {
       name        = "Fall Favorites"
       description = "Enjoy 20% off all fall drinks"
       discount    = 20.0
-      start       = "2024-09-01"
-      end         = "2024-11-30"
+      start       = "2024-09-01T00:00:00-07:00"
+      end         = "2024-12-01T00:00:00-08:00"
       code        = "FALL2024"
+      per_member  = 5
     }
     rewards = {
       name        = "Rewards Member Special"
-      description = "Extra star rewards for members"
+      description = "15% off for members"
       discount    = 15.0
-      start       = "2024-10-01"
-      end         = "2024-12-31"
+      start       = "2024-10-01T00:00:00-07:00"
+      end         = "2025-01-01T00:00:00-08:00"
       code        = "REWARDS15"
+      per_member  = 1
     }
   }
 
-  name                = each.value.name
-  description         = each.value.description
-  discount_percentage = each.value.discount
-  start_date          = each.value.start
-  end_date            = each.value.end
-  applies_to          = "all"
-  is_active           = true
-  promo_code          = each.value.code
+  name                     = each.value.name
+  description              = each.value.description
+  discount_type            = "percentage"
+  discount_value           = each.value.discount
+  start_date               = each.value.start
+  end_date                 = each.value.end
+  promo_code               = each.value.code
+  usage_limit              = 10000
+  usage_limit_per_customer = each.value.per_member
+}
+
+resource "starbucks_promotion" "west_coast_bogo" {
+  name          = "West Coast Macchiato BOGO"
+  discount_type = "bogo"
+  start_date    = "2024-11-01T05:00:00-07:00"
+  end_date      = "2024-11-04T00:00:00-08:00"
+  regions       = ["us-west"]
+  menu_item_ids = [starbucks_menu_item.signature_drinks["caramel_macchiato"].id]
+}
+
+resource "starbucks_promotion" "seattle_free_cookie" {
+  name              = "Seattle Reopening Cookie"
+  discount_type     = "free_item"
+  free_menu_item_id = "menu-item-chocolate-chip-cookie"
+  promo_code        = "SEACOOKIE"
+  usage_limit       = 500
+  store_ids         = [starbucks_store.flagship_stores["seattle"].id]
+}
+
+# Single-use codes for the fall email campaign. Raise count to issue more
+# codes; existing codes are kept.
+resource "starbucks_promo_code_pool" "fall_email" {
+  promotion_id = starbucks_promotion.seasonal_promos["fall"].id
+  prefix       = "FALL"
+  code_length  = 8
+  code_count   = 5000
 }
 
 # Data source examples
@@ -229,6 +425,33 @@ data "starbucks_store" "lookup_seattle" {
   id = starbucks_store.flagship_stores["seattle"].id
 }
 
+data "starbucks_store_effective_hours" "seattle_black_friday" {
+  store_id = starbucks_store.flagship_stores["seattle"].id
+  date     = "2024-11-29"
+}
+
+data "starbucks_store_menu" "seattle_menu" {
+  store_id = starbucks_store.flagship_stores["seattle"].id
+}
+
+data "starbucks_drink_capacity" "seattle" {
+  store_id = starbucks_store.flagship_stores["seattle"].id
+}
+
+data "starbucks_inventory" "seattle_oat_milk" {
+  store_id = starbucks_inventory.seattle_oat_milk.store_id
+  item_sku = starbucks_inventory.seattle_oat_milk.item_sku
+}
+
+data "starbucks_inventory_items" "seattle_low_stock" {
+  store_id             = starbucks_store.flagship_stores["seattle"].id
+  below_threshold_only = true
+}
+
+data "starbucks_inventory_items" "milk" {
+  sku_prefix = "MILK-"
+}
+
 data "starbucks_stores" "washington_stores" {
   state = "WA"
 }
@@ -246,8 +469,31 @@ output "washington_store_count" {
   value = length(data.starbucks_stores.washington_stores.stores)
 }
 
+output "seattle_first_to_sell_out" {
+  value = try(data.starbucks_drink_capacity.seattle.items[0].name, null)
+}
+
+output "seattle_oat_milk_on_hand" {
+  value = data.starbucks_inventory.seattle_oat_milk.quantity
+}
+
+output "seattle_low_stock_skus" {
+  value = [for item in data.starbucks_inventory_items.seattle_low_stock.items : item.item_sku]
+}
+
+output "milk_on_hand_by_store" {
+  value = {
+    for item in data.starbucks_inventory_items.milk.items : "${item.store_id}/${item.item_sku}" => "${item.quantity} ${item.unit}"
+  }
+}
+
 output "promotion_codes" {
   value = {
     for k, v in starbucks_promotion.seasonal_promos : k => v.promo_code
   }
 }
+
+output "fall_email_codes" {
+  value     = starbucks_promo_code_pool.fall_email.codes
+  sensitive = true
+}
@@ -8,20 +8,29 @@ import (
     "github.com/hashicorp/terraform-plugin-framework/provider"
     "github.com/hashicorp/terraform-plugin-framework/provider/schema"
     "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ provider.Provider = &starbucksProvider{}
 
+const (
+    onDestroyDelete  = "delete"
+    onDestroyArchive = "archive"
+    onDestroyAbandon = "abandon"
+)
+
 type starbucksProvider struct {
     version string
 }
 
 type starbucksProviderModel struct {
-    APIKey   types.String `tfsdk:"api_key"`
-    Endpoint types.String `tfsdk:"endpoint"`
-    Region   types.String `tfsdk:"region"`
-    Timeout  types.Int64  `tfsdk:"timeout"`
+    APIKey    types.String `tfsdk:"api_key"`
+    Endpoint  types.String `tfsdk:"endpoint"`
+    Region    types.String `tfsdk:"region"`
+    Timeout   types.Int64  `tfsdk:"timeout"`
+    OnDestroy types.String `tfsdk:"on_destroy"`
+    RedactPII types.Bool   `tfsdk:"redact_pii"`
 }
 
 func New(version string) func() provider.Provider {
@@ -58,6 +67,17 @@ func (p *starbucksProvider) Schema(_ context.Context, _ provider.SchemaRequest,
                 Description: "API request timeout in seconds. Defaults to 30.",
                 Optional:    true,
             },
+            "on_destroy": schema.StringAttribute{
+                Description: "What destroying a store or employee does: delete (hard delete), archive (permanently close the store) or abandon (remove from state only). Defaults to delete. Employees are terminated rather than deleted in both the delete and archive modes.",
+                Optional:    true,
+                Validators: []validator.String{
+                    oneOfValidator{values: []string{onDestroyDelete, onDestroyArchive, onDestroyAbandon}},
+                },
+            },
+            "redact_pii": schema.BoolAttribute{
+                Description: "Keep employee personal data out of state and logs. When enabled, email, phone_number and hourly_rate must be supplied through their write-only *_wo attributes and are masked in provider logs. Defaults to false.",
+                Optional:    true,
+            },
         },
     }
 }
@@ -81,6 +101,7 @@ func (p *starbucksProvider) Configure(ctx context.Context, req provider.Configur
     endpoint := "https://api.starbucks.com/v1"
     region := "us-west-2"
     timeout := int64(30)
+    onDestroy := onDestroyDelete
 
     if !config.APIKey.IsNull() {
         apiKey = config.APIKey.ValueString()
@@ -94,6 +115,9 @@ func (p *starbucksProvider) Configure(ctx context.Context, req provider.Configur
     if !config.Timeout.IsNull() {
         timeout = config.Timeout.ValueInt64()
     }
+    if !config.OnDestroy.IsNull() {
+        onDestroy = config.OnDestroy.ValueString()
+    }
 
     if apiKey == "" {
         resp.Diagnostics.AddError(
@@ -104,6 +128,8 @@ func (p *starbucksProvider) Configure(ctx context.Context, req provider.Configur
     }
 
     client := NewStarbucksClient(apiKey, endpoint, region, timeout)
+    client.OnDestroy = onDestroy
+    client.RedactPII = config.RedactPII.ValueBool()
     resp.DataSourceData = client
     resp.ResourceData = client
 }
@@ -115,6 +141,19 @@ func (p *starbucksProvider) Resources(_ context.Context) []func() resource.Resou
         NewMenuItemResource,
         NewInventoryResource,
         NewPromotionResource,
+        NewStoreHoursExceptionResource,
+        NewEmployeeCertificationResource,
+        NewScheduleResource,
+        NewStoreMenuItemResource,
+        NewMenuResource,
+        NewMenuCustomizationResource,
+        NewStoreInventoryResource,
+        NewInventoryTransferResource,
+        NewReorderRuleResource,
+        NewPurchaseOrderResource,
+        NewSupplierResource,
+        NewProductResource,
+        NewPromoCodePoolResource,
     }
 }
 
@@ -122,5 +161,11 @@ func (p *starbucksProvider) DataSources(_ context.Context) []func() datasource.D
     return []func() datasource.DataSource{
         NewStoreDataSource,
         NewStoresDataSource,
+        NewStoreEffectiveHoursDataSource,
+        NewCertificationsDataSource,
+        NewStoreMenuDataSource,
+        NewDrinkCapacityDataSource,
+        NewInventoryDataSource,
+        NewInventoryItemsDataSource,
     }
 }
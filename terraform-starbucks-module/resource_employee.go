@@ -4,16 +4,27 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "net/url"
+    "strings"
+    "time"
 
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &employeeResource{}
+var _ resource.ResourceWithValidateConfig = &employeeResource{}
+var _ resource.ResourceWithModifyPlan = &employeeResource{}
 
 type employeeResource struct {
     client *StarbucksClient
@@ -34,10 +45,43 @@ type employeeResourceModel struct {
     IsShiftSupervisor types.Bool   `tfsdk:"is_shift_supervisor"`
     IsCertified      types.Bool    `tfsdk:"is_certified"`
     AvailableHours   types.String  `tfsdk:"available_hours"`
+    WeeklyHours      types.Map     `tfsdk:"weekly_hours"`
+    Timezone         types.String  `tfsdk:"timezone"`
     EmploymentType   types.String  `tfsdk:"employment_type"`
     Status           types.String  `tfsdk:"status"`
+    DeletionProtection types.Bool  `tfsdk:"deletion_protection"`
+    TerminationReason types.String `tfsdk:"termination_reason"`
+    FinalWorkDate    types.String  `tfsdk:"final_work_date"`
+    Rehire           types.Bool    `tfsdk:"rehire"`
+    TransferEffectiveDate types.String `tfsdk:"transfer_effective_date"`
+    TransferHistory  types.List    `tfsdk:"transfer_history"`
+    EmailWO          types.String  `tfsdk:"email_wo"`
+    EmailWOVersion   types.Int64   `tfsdk:"email_wo_version"`
+    PhoneNumberWO    types.String  `tfsdk:"phone_number_wo"`
+    PhoneNumberWOVersion types.Int64 `tfsdk:"phone_number_wo_version"`
+    HourlyRateWO     types.Float64 `tfsdk:"hourly_rate_wo"`
+    HourlyRateWOVersion types.Int64 `tfsdk:"hourly_rate_wo_version"`
 }
 
+// employeePIIFields are the request fields treated as personal data. They are
+// always masked in logs, and also kept out of state and error messages when
+// the provider's redact_pii setting is enabled.
+var employeePIIFields = []string{"email", "phone_number", "hourly_rate"}
+
+type employeeTransferModel struct {
+    FromStoreID   types.String `tfsdk:"from_store_id"`
+    ToStoreID     types.String `tfsdk:"to_store_id"`
+    EffectiveDate types.String `tfsdk:"effective_date"`
+}
+
+var employeeTransferAttrTypes = map[string]attr.Type{
+    "from_store_id":  types.StringType,
+    "to_store_id":    types.StringType,
+    "effective_date": types.StringType,
+}
+
+const employeeStatusTerminated = "terminated"
+
 func NewEmployeeResource() resource.Resource {
     return &employeeResource{}
 }
@@ -48,7 +92,7 @@ func (r *employeeResource) Metadata(_ context.Context, req resource.MetadataRequ
 
 func (r *employeeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
     resp.Schema = schema.Schema{
-        Description: "Manages a Starbucks employee (partner) with full employee lifecycle.",
+        Description: "Manages a Starbucks employee (partner) with full employee lifecycle. Destroying an employee terminates the partner record instead of deleting it, unless the provider on_destroy mode is abandon.",
         Attributes: map[string]schema.Attribute{
             "id": schema.StringAttribute{
                 Description: "Unique identifier",
@@ -70,17 +114,65 @@ func (r *employeeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
                 Required:    true,
             },
             "email": schema.StringAttribute{
-                Description: "Email address",
-                Required:    true,
+                Description: "Email address. Exactly one of email or email_wo must be set.",
+                Optional:    true,
+            },
+            "email_wo": schema.StringAttribute{
+                Description: "Write-only email address, never stored in state. Requires Terraform 1.11+.",
+                Optional:    true,
+                Sensitive:   true,
+                WriteOnly:   true,
+            },
+            "email_wo_version": schema.Int64Attribute{
+                Description: "Change this value to send a new email_wo to the API.",
+                Optional:    true,
             },
             "phone_number": schema.StringAttribute{
                 Description: "Contact phone number",
                 Optional:    true,
             },
+            "phone_number_wo": schema.StringAttribute{
+                Description: "Write-only contact phone number, never stored in state. Requires Terraform 1.11+.",
+                Optional:    true,
+                Sensitive:   true,
+                WriteOnly:   true,
+            },
+            "phone_number_wo_version": schema.Int64Attribute{
+                Description: "Change this value to send a new phone_number_wo to the API.",
+                Optional:    true,
+            },
             "store_id": schema.StringAttribute{
-                Description: "ID of the assigned store",
+                Description: "ID of the assigned store. Changing it transfers the employee; the target store must exist and be active.",
                 Required:    true,
             },
+            "transfer_effective_date": schema.StringAttribute{
+                Description: "Effective date used when store_id changes (YYYY-MM-DD format). Defaults to the date of the apply.",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "transfer_history": schema.ListNestedAttribute{
+                Description: "Store transfers of the employee, oldest first",
+                Computed:    true,
+                PlanModifiers: []planmodifier.List{
+                    listplanmodifier.UseStateForUnknown(),
+                },
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "from_store_id": schema.StringAttribute{
+                            Description: "Store the employee was transferred from",
+                            Computed:    true,
+                        },
+                        "to_store_id": schema.StringAttribute{
+                            Description: "Store the employee was transferred to",
+                            Computed:    true,
+                        },
+                        "effective_date": schema.StringAttribute{
+                            Description: "Date the transfer took effect (YYYY-MM-DD format)",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
             "position": schema.StringAttribute{
                 Description: "Job position: barista, shift_supervisor, store_manager, assistant_manager",
                 Required:    true,
@@ -90,9 +182,19 @@ func (r *employeeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
                 Required:    true,
             },
             "hourly_rate": schema.Float64Attribute{
-                Description: "Hourly pay rate (USD)",
+                Description: "Hourly pay rate (USD). Stored in state; prefer hourly_rate_wo.",
+                Optional:    true,
+                Sensitive:   true,
+            },
+            "hourly_rate_wo": schema.Float64Attribute{
+                Description: "Write-only hourly pay rate (USD), never stored in state. Requires Terraform 1.11+.",
                 Optional:    true,
                 Sensitive:   true,
+                WriteOnly:   true,
+            },
+            "hourly_rate_wo_version": schema.Int64Attribute{
+                Description: "Change this value to send a new hourly_rate_wo to the API.",
+                Optional:    true,
             },
             "is_barista": schema.BoolAttribute{
                 Description: "Whether employee is a certified barista",
@@ -113,9 +215,12 @@ func (r *employeeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
                 Default:     booldefault.StaticBool(false),
             },
             "available_hours": schema.StringAttribute{
-                Description: "Available working hours (e.g., 'Mon-Fri: 9AM-5PM')",
-                Optional:    true,
+                Description:        "Available working hours (e.g., 'Mon-Fri: 9AM-5PM')",
+                Optional:           true,
+                DeprecationMessage: "Use weekly_hours instead. available_hours is free-form and cannot be validated.",
             },
+            "weekly_hours": weeklyHoursSchemaAttribute("Structured working hours the employee is available for."),
+            "timezone":     timezoneSchemaAttribute("IANA time zone the weekly_hours are expressed in (e.g., America/Los_Angeles)"),
             "employment_type": schema.StringAttribute{
                 Description: "Employment type: full_time, part_time, seasonal",
                 Optional:    true,
@@ -124,6 +229,27 @@ func (r *employeeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
                 Description: "Employment status",
                 Computed:    true,
             },
+            "deletion_protection": schema.BoolAttribute{
+                Description: "Whether Terraform is prevented from destroying the employee. Must be set to false and applied before the employee can be destroyed.",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+            },
+            "termination_reason": schema.StringAttribute{
+                Description: "Reason recorded when the employee is terminated on destroy (e.g., 'resignation', 'end_of_season')",
+                Optional:    true,
+            },
+            "final_work_date": schema.StringAttribute{
+                Description: "Final work date recorded when the employee is terminated on destroy (YYYY-MM-DD format). Defaults to the date of the destroy.",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "rehire": schema.BoolAttribute{
+                Description: "Whether creating an employee whose employee_number belongs to a terminated partner rehires that partner record instead of creating a new one",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+            },
         },
     }
 }
@@ -140,18 +266,123 @@ func (r *employeeResource) Configure(_ context.Context, req resource.ConfigureRe
     r.client = client
 }
 
-func (r *employeeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *employeeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config employeeResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.AvailableHours.IsNull() && !config.WeeklyHours.IsNull() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("weekly_hours"),
+            "Conflicting Available Hours",
+            "Only one of available_hours or weekly_hours may be set.",
+        )
+    }
+
+    if config.Email.IsNull() && config.EmailWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("email"), "Missing Email", "One of email or email_wo must be set.")
+    }
+    if !config.Email.IsNull() && !config.EmailWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("email_wo"), "Conflicting Email", "Only one of email or email_wo may be set.")
+    }
+    if !config.PhoneNumber.IsNull() && !config.PhoneNumberWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("phone_number_wo"), "Conflicting Phone Number", "Only one of phone_number or phone_number_wo may be set.")
+    }
+    if !config.HourlyRate.IsNull() && !config.HourlyRateWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("hourly_rate_wo"), "Conflicting Hourly Rate", "Only one of hourly_rate or hourly_rate_wo may be set.")
+    }
+    if !config.EmailWOVersion.IsNull() && config.EmailWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("email_wo_version"), "Missing Write-Only Value", "email_wo_version can only be set together with email_wo.")
+    }
+    if !config.PhoneNumberWOVersion.IsNull() && config.PhoneNumberWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("phone_number_wo_version"), "Missing Write-Only Value", "phone_number_wo_version can only be set together with phone_number_wo.")
+    }
+    if !config.HourlyRateWOVersion.IsNull() && config.HourlyRateWO.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("hourly_rate_wo_version"), "Missing Write-Only Value", "hourly_rate_wo_version can only be set together with hourly_rate_wo.")
+    }
+}
+
+func (r *employeeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() {
+        return
+    }
+
     var plan employeeResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
+    if r.client != nil && r.client.RedactPII {
+        if !plan.Email.IsNull() {
+            resp.Diagnostics.AddAttributeError(path.Root("email"), "Personal Data Redaction Enabled",
+                "The provider has redact_pii enabled, so email would not be kept out of state. Use email_wo instead.")
+        }
+        if !plan.PhoneNumber.IsNull() {
+            resp.Diagnostics.AddAttributeError(path.Root("phone_number"), "Personal Data Redaction Enabled",
+                "The provider has redact_pii enabled, so phone_number would not be kept out of state. Use phone_number_wo instead.")
+        }
+        if !plan.HourlyRate.IsNull() {
+            resp.Diagnostics.AddAttributeError(path.Root("hourly_rate"), "Personal Data Redaction Enabled",
+                "The provider has redact_pii enabled, so hourly_rate would not be kept out of state. Use hourly_rate_wo instead.")
+        }
+    }
+
+    if req.State.Raw.IsNull() {
+        return
+    }
+
+    var state employeeResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !plan.Position.Equal(state.Position) || !plan.IsBarista.Equal(state.IsBarista) || !plan.IsCertified.Equal(state.IsCertified) {
+        r.warnMissingCertifications(state.ID.ValueString(), plan, resp)
+    }
+
+    if plan.StoreID.IsUnknown() || plan.StoreID.Equal(state.StoreID) {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("transfer_history"), types.ListUnknown(types.ObjectType{AttrTypes: employeeTransferAttrTypes}))...)
+
+    if r.client == nil {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", "/stores/"+plan.StoreID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddAttributeError(path.Root("store_id"), "Invalid Transfer Target",
+            fmt.Sprintf("Unable to read target store %s: %s", plan.StoreID.ValueString(), err))
+        return
+    }
+    var store map[string]interface{}
+    if err := json.Unmarshal(respBody, &store); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if status, ok := store["status"].(string); ok && status != storeStatusActive {
+        resp.Diagnostics.AddAttributeError(path.Root("store_id"), "Invalid Transfer Target",
+            fmt.Sprintf("Employee %s cannot be transferred to store %s because its status is %s.", state.EmployeeNumber.ValueString(), plan.StoreID.ValueString(), status))
+    }
+}
+
+func (r *employeeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan, config employeeResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
     requestBody := map[string]interface{}{
         "employee_number":     plan.EmployeeNumber.ValueString(),
         "first_name":          plan.FirstName.ValueString(),
         "last_name":           plan.LastName.ValueString(),
-        "email":               plan.Email.ValueString(),
         "store_id":            plan.StoreID.ValueString(),
         "position":            plan.Position.ValueString(),
         "hire_date":           plan.HireDate.ValueString(),
@@ -160,9 +391,41 @@ func (r *employeeResource) Create(ctx context.Context, req resource.CreateReques
         "is_certified":        plan.IsCertified.ValueBool(),
     }
 
-    respBody, err := r.client.DoRequest("POST", "/employees", requestBody)
+    if !plan.AvailableHours.IsNull() {
+        requestBody["available_hours"] = plan.AvailableHours.ValueString()
+    }
+    if !plan.WeeklyHours.IsNull() {
+        weeklyHours, diags := expandWeeklyHours(ctx, plan.WeeklyHours)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        requestBody["weekly_hours"] = weeklyHours
+    }
+    if !plan.Timezone.IsNull() {
+        requestBody["timezone"] = plan.Timezone.ValueString()
+    }
+
+    setEmployeePIIFields(requestBody, config, nil)
+
+    requestPath := "/employees"
+    if plan.Rehire.ValueBool() {
+        terminatedID, err := r.findTerminatedEmployee(plan.EmployeeNumber.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up employee %s for rehire: %s", plan.EmployeeNumber.ValueString(), err))
+            return
+        }
+        if terminatedID != "" {
+            requestPath = "/employees/" + terminatedID + "/rehire"
+        }
+    }
+
+    ctx = r.logContext(ctx, requestBody)
+    tflog.Debug(ctx, "Creating employee", employeeLogFields(requestBody))
+
+    respBody, err := r.client.DoRequest("POST", requestPath, requestBody)
     if err != nil {
-        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create employee: %s", err))
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create employee: %s", r.redact(err.Error(), requestBody)))
         return
     }
 
@@ -176,6 +439,10 @@ func (r *employeeResource) Create(ctx context.Context, req resource.CreateReques
     }
     plan.Status = types.StringValue("active")
 
+    transferHistory, diags := flattenTransferHistory(ctx, result["transfer_history"])
+    resp.Diagnostics.Append(diags...)
+    plan.TransferHistory = transferHistory
+
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -199,33 +466,117 @@ func (r *employeeResource) Read(ctx context.Context, req resource.ReadRequest, r
     }
 
     if val, ok := result["status"].(string); ok {
+        if val == employeeStatusTerminated {
+            resp.State.RemoveResource(ctx)
+            return
+        }
         state.Status = types.StringValue(val)
     }
+    if val, ok := result["weekly_hours"]; ok {
+        weeklyHours, diags := flattenWeeklyHours(ctx, state.WeeklyHours, val)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        state.WeeklyHours = weeklyHours
+    }
+    if val, ok := result["transfer_history"]; ok {
+        transferHistory, diags := flattenTransferHistory(ctx, val)
+        resp.Diagnostics.Append(diags...)
+        state.TransferHistory = transferHistory
+    }
+    // Until a transfer takes effect the API still reports the previous store,
+    // so keep the transfer target in state while it is pending.
+    if val, ok := result["store_id"].(string); ok {
+        pending, diags := transferPending(ctx, state.TransferHistory, state.StoreID.ValueString(), time.Now())
+        resp.Diagnostics.Append(diags...)
+        if !pending {
+            state.StoreID = types.StringValue(val)
+        }
+    }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *employeeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-    var plan employeeResourceModel
+    var plan, state, config employeeResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
+    plan.TransferHistory = state.TransferHistory
+    if !plan.StoreID.Equal(state.StoreID) {
+        effectiveDate := time.Now().Format(dateLayout)
+        if !plan.TransferEffectiveDate.IsNull() {
+            effectiveDate = plan.TransferEffectiveDate.ValueString()
+        }
+
+        respBody, err := r.client.DoRequest("POST", "/employees/"+plan.ID.ValueString()+"/transfer", map[string]interface{}{
+            "store_id":       plan.StoreID.ValueString(),
+            "effective_date": effectiveDate,
+        })
+        if err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transfer employee to store %s: %s", plan.StoreID.ValueString(), err))
+            return
+        }
+
+        var result map[string]interface{}
+        if err := json.Unmarshal(respBody, &result); err == nil && result["transfer_history"] != nil {
+            transferHistory, diags := flattenTransferHistory(ctx, result["transfer_history"])
+            resp.Diagnostics.Append(diags...)
+            plan.TransferHistory = transferHistory
+        } else {
+            var history []employeeTransferModel
+            resp.Diagnostics.Append(state.TransferHistory.ElementsAs(ctx, &history, false)...)
+            history = append(history, employeeTransferModel{
+                FromStoreID:   state.StoreID,
+                ToStoreID:     plan.StoreID,
+                EffectiveDate: types.StringValue(effectiveDate),
+            })
+            transferHistory, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: employeeTransferAttrTypes}, history)
+            resp.Diagnostics.Append(diags...)
+            plan.TransferHistory = transferHistory
+        }
+        if resp.Diagnostics.HasError() {
+            return
+        }
+    }
+
     requestBody := map[string]interface{}{
         "first_name": plan.FirstName.ValueString(),
         "last_name":  plan.LastName.ValueString(),
-        "email":      plan.Email.ValueString(),
-        "phone_number": func() interface{} {
-            if plan.PhoneNumber.IsNull() { return nil }
-            return plan.PhoneNumber.ValueString()
-        }(),
-        "position": plan.Position.ValueString(),
+        "position":        plan.Position.ValueString(),
+        "available_hours": nil,
+        "weekly_hours":    nil,
+        "timezone":        nil,
     }
 
+    if !plan.AvailableHours.IsNull() {
+        requestBody["available_hours"] = plan.AvailableHours.ValueString()
+    }
+    if !plan.WeeklyHours.IsNull() {
+        weeklyHours, diags := expandWeeklyHours(ctx, plan.WeeklyHours)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        requestBody["weekly_hours"] = weeklyHours
+    }
+    if !plan.Timezone.IsNull() {
+        requestBody["timezone"] = plan.Timezone.ValueString()
+    }
+
+    setEmployeePIIFields(requestBody, config, &state)
+
+    ctx = r.logContext(ctx, requestBody)
+    tflog.Debug(ctx, "Updating employee", employeeLogFields(requestBody))
+
     _, err := r.client.DoRequest("PUT", "/employees/"+plan.ID.ValueString(), requestBody)
     if err != nil {
-        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update employee: %s", err))
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update employee: %s", r.redact(err.Error(), requestBody)))
         return
     }
 
@@ -239,9 +590,202 @@ func (r *employeeResource) Delete(ctx context.Context, req resource.DeleteReques
         return
     }
 
-    _, err := r.client.DoRequest("DELETE", "/employees/"+state.ID.ValueString(), nil)
+    if state.DeletionProtection.ValueBool() {
+        resp.Diagnostics.AddError(
+            "Employee Deletion Protected",
+            fmt.Sprintf("Employee %s (%s) has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", state.ID.ValueString(), state.EmployeeNumber.ValueString()),
+        )
+        return
+    }
+
+    if r.client.OnDestroy == onDestroyAbandon {
+        return
+    }
+
+    finalWorkDate := time.Now().Format(dateLayout)
+    if !state.FinalWorkDate.IsNull() {
+        finalWorkDate = state.FinalWorkDate.ValueString()
+    }
+    requestBody := map[string]interface{}{
+        "final_work_date": finalWorkDate,
+    }
+    if !state.TerminationReason.IsNull() {
+        requestBody["termination_reason"] = state.TerminationReason.ValueString()
+    }
+
+    _, err := r.client.DoRequest("POST", "/employees/"+state.ID.ValueString()+"/terminate", requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to terminate employee: %s", err))
+        return
+    }
+}
+
+// warnMissingCertifications adds a plan warning for every certification
+// required by the planned position that the employee lacks or let expire. It
+// is only called when the position or certification flags change.
+func (r *employeeResource) warnMissingCertifications(id string, plan employeeResourceModel, resp *resource.ModifyPlanResponse) {
+    if r.client == nil || id == "" || plan.Position.IsUnknown() {
+        return
+    }
+    required, ok := positionRequiredCertifications[plan.Position.ValueString()]
+    if !ok {
+        return
+    }
+
+    certifications, err := r.client.ListAll(employeeCertificationsPath(id))
     if err != nil {
-        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete employee: %s", err))
+        resp.Diagnostics.AddWarning("Unable to Check Certifications",
+            fmt.Sprintf("Unable to list certifications of employee %s: %s", plan.EmployeeNumber.ValueString(), err))
         return
     }
+
+    held := map[string]string{}
+    for _, cert := range flattenCertifications(certifications, time.Now()) {
+        if held[cert.CertificationType.ValueString()] != "valid" {
+            held[cert.CertificationType.ValueString()] = cert.Status.ValueString()
+        }
+    }
+
+    for _, certificationType := range required {
+        switch held[certificationType] {
+        case "valid":
+        case "":
+            resp.Diagnostics.AddAttributeWarning(path.Root("position"), "Missing Certification",
+                fmt.Sprintf("Employee %s is a %s but does not hold the required %s certification.", plan.EmployeeNumber.ValueString(), plan.Position.ValueString(), certificationType))
+        default:
+            resp.Diagnostics.AddAttributeWarning(path.Root("position"), "Expired Certification",
+                fmt.Sprintf("Employee %s is a %s but their required %s certification has expired.", plan.EmployeeNumber.ValueString(), plan.Position.ValueString(), certificationType))
+        }
+    }
+}
+
+// setEmployeePIIFields adds email, phone_number and hourly_rate to
+// requestBody from config. Write-only values are sent on create (prior is
+// nil) and afterwards only when their *_wo_version changes, since Terraform
+// cannot tell whether they changed.
+func setEmployeePIIFields(requestBody map[string]interface{}, config employeeResourceModel, prior *employeeResourceModel) {
+    switch {
+    case !config.Email.IsNull():
+        requestBody["email"] = config.Email.ValueString()
+    case !config.EmailWO.IsNull() && (prior == nil || !config.EmailWOVersion.Equal(prior.EmailWOVersion)):
+        requestBody["email"] = config.EmailWO.ValueString()
+    }
+
+    switch {
+    case !config.PhoneNumber.IsNull():
+        requestBody["phone_number"] = config.PhoneNumber.ValueString()
+    case !config.PhoneNumberWO.IsNull():
+        if prior == nil || !config.PhoneNumberWOVersion.Equal(prior.PhoneNumberWOVersion) {
+            requestBody["phone_number"] = config.PhoneNumberWO.ValueString()
+        }
+    case prior != nil:
+        requestBody["phone_number"] = nil
+    }
+
+    switch {
+    case !config.HourlyRate.IsNull():
+        requestBody["hourly_rate"] = config.HourlyRate.ValueFloat64()
+    case !config.HourlyRateWO.IsNull():
+        if prior == nil || !config.HourlyRateWOVersion.Equal(prior.HourlyRateWOVersion) {
+            requestBody["hourly_rate"] = config.HourlyRateWO.ValueFloat64()
+        }
+    case prior != nil:
+        requestBody["hourly_rate"] = nil
+    }
+}
+
+// logContext masks personal data in log entries. Logs are masked whether or
+// not redact_pii is enabled, since TF_LOG output is often shared verbatim.
+func (r *employeeResource) logContext(ctx context.Context, requestBody map[string]interface{}) context.Context {
+    ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, employeePIIFields...)
+    for _, field := range employeePIIFields {
+        if value, ok := requestBody[field].(string); ok && value != "" {
+            ctx = tflog.MaskMessageStrings(ctx, value)
+        }
+    }
+    return ctx
+}
+
+// employeeLogFields returns the fields of requestBody that are safe to log,
+// leaving out personal data entirely rather than relying on masking.
+func employeeLogFields(requestBody map[string]interface{}) map[string]interface{} {
+    fields := make(map[string]interface{}, len(requestBody))
+    for key, value := range requestBody {
+        fields[key] = value
+    }
+    for _, field := range employeePIIFields {
+        delete(fields, field)
+    }
+    return fields
+}
+
+// redact replaces personal data from requestBody in message, e.g. an API
+// error echoing the request, when redact_pii is enabled.
+func (r *employeeResource) redact(message string, requestBody map[string]interface{}) string {
+    if !r.client.RedactPII {
+        return message
+    }
+    for _, field := range employeePIIFields {
+        if value, ok := requestBody[field].(string); ok && value != "" {
+            message = strings.ReplaceAll(message, value, "***")
+        }
+    }
+    return message
+}
+
+// flattenTransferHistory converts the API transfer_history into a list,
+// returning an empty list when the API has no transfers recorded.
+func flattenTransferHistory(ctx context.Context, raw interface{}) (types.List, diag.Diagnostics) {
+    history := []employeeTransferModel{}
+    entries, _ := raw.([]interface{})
+    for _, e := range entries {
+        entry, ok := e.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        from, _ := entry["from_store_id"].(string)
+        to, _ := entry["to_store_id"].(string)
+        effectiveDate, _ := entry["effective_date"].(string)
+        history = append(history, employeeTransferModel{
+            FromStoreID:   types.StringValue(from),
+            ToStoreID:     types.StringValue(to),
+            EffectiveDate: types.StringValue(effectiveDate),
+        })
+    }
+    return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: employeeTransferAttrTypes}, history)
+}
+
+// transferPending reports whether history holds a transfer to storeID that
+// takes effect after now.
+func transferPending(ctx context.Context, history types.List, storeID string, now time.Time) (bool, diag.Diagnostics) {
+    if history.IsNull() || history.IsUnknown() {
+        return false, nil
+    }
+    var transfers []employeeTransferModel
+    diags := history.ElementsAs(ctx, &transfers, false)
+    today := now.Format(dateLayout)
+    for _, transfer := range transfers {
+        if transfer.ToStoreID.ValueString() == storeID && transfer.EffectiveDate.ValueString() > today {
+            return true, diags
+        }
+    }
+    return false, diags
+}
+
+// findTerminatedEmployee returns the ID of the terminated partner record with
+// the given employee number, or "" if there is none.
+func (r *employeeResource) findTerminatedEmployee(employeeNumber string) (string, error) {
+    employees, err := r.client.ListAll("/employees?employee_number=" + url.QueryEscape(employeeNumber))
+    if err != nil {
+        return "", err
+    }
+    for _, employee := range employees {
+        number, _ := employee["employee_number"].(string)
+        status, _ := employee["status"].(string)
+        id, _ := employee["id"].(string)
+        if number == employeeNumber && status == employeeStatusTerminated && id != "" {
+            return id, nil
+        }
+    }
+    return "", nil
 }
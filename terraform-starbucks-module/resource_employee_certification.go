@@ -0,0 +1,278 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &employeeCertificationResource{}
+var _ resource.ResourceWithImportState = &employeeCertificationResource{}
+var _ resource.ResourceWithValidateConfig = &employeeCertificationResource{}
+
+var certificationTypes = []string{
+    "barista_basics",
+    "food_safety",
+    "shift_supervisor",
+    "coffee_master",
+    "reserve_coffee_master",
+}
+
+// positionRequiredCertifications lists the certifications an employee must
+// hold for each position.
+var positionRequiredCertifications = map[string][]string{
+    "barista":           {"barista_basics", "food_safety"},
+    "shift_supervisor":  {"barista_basics", "food_safety", "shift_supervisor"},
+    "assistant_manager": {"food_safety", "shift_supervisor"},
+    "store_manager":     {"food_safety", "shift_supervisor"},
+}
+
+type employeeCertificationResource struct {
+    client *StarbucksClient
+}
+
+type employeeCertificationResourceModel struct {
+    ID                types.String `tfsdk:"id"`
+    EmployeeID        types.String `tfsdk:"employee_id"`
+    CertificationType types.String `tfsdk:"certification_type"`
+    IssuedDate        types.String `tfsdk:"issued_date"`
+    ExpiryDate        types.String `tfsdk:"expiry_date"`
+    Status            types.String `tfsdk:"status"`
+}
+
+func NewEmployeeCertificationResource() resource.Resource {
+    return &employeeCertificationResource{}
+}
+
+func (r *employeeCertificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_employee_certification"
+}
+
+func (r *employeeCertificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a certification held by a Starbucks employee (partner).",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the certification",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "employee_id": schema.StringAttribute{
+                Description: "ID of the certified employee",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "certification_type": schema.StringAttribute{
+                Description: "Certification: " + strings.Join(certificationTypes, ", "),
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+                Validators: []validator.String{
+                    oneOfValidator{values: certificationTypes},
+                },
+            },
+            "issued_date": schema.StringAttribute{
+                Description: "Date the certification was issued (YYYY-MM-DD format)",
+                Required:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "expiry_date": schema.StringAttribute{
+                Description: "Date the certification expires (YYYY-MM-DD format). Omit for certifications that do not expire.",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "status": schema.StringAttribute{
+                Description: "Certification status: valid or expired",
+                Computed:    true,
+            },
+        },
+    }
+}
+
+func (r *employeeCertificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *employeeCertificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config employeeCertificationResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if config.IssuedDate.IsUnknown() || config.ExpiryDate.IsUnknown() || config.ExpiryDate.IsNull() {
+        return
+    }
+    issued, issuedErr := time.Parse(dateLayout, config.IssuedDate.ValueString())
+    expiry, expiryErr := time.Parse(dateLayout, config.ExpiryDate.ValueString())
+    if issuedErr == nil && expiryErr == nil && !expiry.After(issued) {
+        resp.Diagnostics.AddAttributeError(path.Root("expiry_date"), "Invalid Expiry Date",
+            fmt.Sprintf("expiry_date %s must be after issued_date %s", config.ExpiryDate.ValueString(), config.IssuedDate.ValueString()))
+    }
+}
+
+func employeeCertificationsPath(employeeID string) string {
+    return "/employees/" + employeeID + "/certifications"
+}
+
+// certificationStatus derives a certification's status from its expiry date
+// for APIs that do not report one.
+func certificationStatus(expiryDate string, now time.Time) string {
+    expiry, err := time.Parse(dateLayout, expiryDate)
+    if err == nil && !now.Before(expiry.AddDate(0, 0, 1)) {
+        return "expired"
+    }
+    return "valid"
+}
+
+func (r *employeeCertificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan employeeCertificationResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody := map[string]interface{}{
+        "certification_type": plan.CertificationType.ValueString(),
+        "issued_date":        plan.IssuedDate.ValueString(),
+    }
+    if !plan.ExpiryDate.IsNull() {
+        requestBody["expiry_date"] = plan.ExpiryDate.ValueString()
+    }
+
+    respBody, err := r.client.DoRequest("POST", employeeCertificationsPath(plan.EmployeeID.ValueString()), requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create employee certification: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+    plan.Status = types.StringValue(certificationStatus(plan.ExpiryDate.ValueString(), time.Now()))
+    if val, ok := result["status"].(string); ok {
+        plan.Status = types.StringValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *employeeCertificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state employeeCertificationResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", employeeCertificationsPath(state.EmployeeID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employee certification: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["certification_type"].(string); ok {
+        state.CertificationType = types.StringValue(val)
+    }
+    if val, ok := result["issued_date"].(string); ok {
+        state.IssuedDate = types.StringValue(val)
+    }
+    if val, ok := result["expiry_date"].(string); ok {
+        state.ExpiryDate = types.StringValue(val)
+    }
+    state.Status = types.StringValue(certificationStatus(state.ExpiryDate.ValueString(), time.Now()))
+    if val, ok := result["status"].(string); ok {
+        state.Status = types.StringValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *employeeCertificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan employeeCertificationResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody := map[string]interface{}{
+        "issued_date": plan.IssuedDate.ValueString(),
+        "expiry_date": nil,
+    }
+    if !plan.ExpiryDate.IsNull() {
+        requestBody["expiry_date"] = plan.ExpiryDate.ValueString()
+    }
+
+    respBody, err := r.client.DoRequest("PUT", employeeCertificationsPath(plan.EmployeeID.ValueString())+"/"+plan.ID.ValueString(), requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update employee certification: %s", err))
+        return
+    }
+    plan.Status = types.StringValue(certificationStatus(plan.ExpiryDate.ValueString(), time.Now()))
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err == nil {
+        if val, ok := result["status"].(string); ok {
+            plan.Status = types.StringValue(val)
+        }
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *employeeCertificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state employeeCertificationResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", employeeCertificationsPath(state.EmployeeID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete employee certification: %s", err))
+        return
+    }
+}
+
+func (r *employeeCertificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    parts := strings.Split(req.ID, "/")
+    if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+        resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form employee_id/certification_id, got: %q", req.ID))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("employee_id"), parts[0])...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
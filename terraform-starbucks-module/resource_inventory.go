@@ -4,20 +4,65 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "strings"
 
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var _ resource.ResourceWithValidateConfig = &inventoryResource{}
+var _ resource.ResourceWithModifyPlan = &inventoryResource{}
+
+const (
+    quantityModeAbsolute   = "absolute"
+    quantityModeAdjustment = "adjustment"
+)
+
+var inventoryAdjustmentTypes = []string{"receive", "waste", "transfer_in", "transfer_out"}
+
 type inventoryResource struct { client *StarbucksClient }
 
 type inventoryResourceModel struct {
-    ID        types.String `tfsdk:"id"`
-    StoreID   types.String `tfsdk:"store_id"`
-    ItemSKU   types.String `tfsdk:"item_sku"`
-    Quantity  types.Int64  `tfsdk:"quantity"`
-    Threshold types.Int64  `tfsdk:"threshold"`
+    ID               types.String `tfsdk:"id"`
+    StoreID          types.String `tfsdk:"store_id"`
+    ItemSKU          types.String `tfsdk:"item_sku"`
+    ItemName         types.String `tfsdk:"item_name"`
+    ItemType         types.String `tfsdk:"item_type"`
+    Quantity         types.Int64  `tfsdk:"quantity"`
+    Unit             types.String `tfsdk:"unit"`
+    Threshold        types.Int64  `tfsdk:"threshold"`
+    ReorderLevel     types.Int64  `tfsdk:"reorder_level"`
+    ReorderLevelUnit types.String `tfsdk:"reorder_level_unit"`
+    LastRestocked    types.String `tfsdk:"last_restocked"`
+    NeedsReorder     types.Bool   `tfsdk:"needs_reorder"`
+    QuantityMode     types.String `tfsdk:"quantity_mode"`
+    IgnoreDrift      types.Bool   `tfsdk:"ignore_quantity_drift"`
+    Adjustments      types.List   `tfsdk:"adjustments"`
+    CurrentQuantity  types.Int64  `tfsdk:"current_quantity"`
+}
+
+type inventoryAdjustmentModel struct {
+    Type     types.String `tfsdk:"type"`
+    Quantity types.Int64  `tfsdk:"quantity"`
+    Unit     types.String `tfsdk:"unit"`
+    Reason   types.String `tfsdk:"reason"`
+}
+
+var inventoryAdjustmentAttrTypes = map[string]attr.Type{
+    "type":     types.StringType,
+    "quantity": types.Int64Type,
+    "unit":     types.StringType,
+    "reason":   types.StringType,
 }
 
 func NewInventoryResource() resource.Resource { return &inventoryResource{} }
@@ -27,14 +72,64 @@ func (r *inventoryResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *inventoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    units := inventoryUnitNames()
     resp.Schema = schema.Schema{
         Description: "Manages inventory items for a store.",
         Attributes: map[string]schema.Attribute{
-            "id": schema.StringAttribute{Computed: true},
-            "store_id": schema.StringAttribute{Required: true},
-            "item_sku": schema.StringAttribute{Required: true},
-            "quantity": schema.Int64Attribute{Required: true},
-            "threshold": schema.Int64Attribute{Optional: true},
+            "id": schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+            "store_id": schema.StringAttribute{Required: true, PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+            "item_sku": schema.StringAttribute{Description: "SKU of the item. Must exist in the product catalog.", Required: true, PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+            "item_name": schema.StringAttribute{Description: "Display name of the item, e.g. Pike Place Roast", Optional: true},
+            "item_type": schema.StringAttribute{Description: "Kind of item, e.g. beans, milk or cups", Optional: true},
+            "quantity": schema.Int64Attribute{Description: "Quantity on hand, in unit. In adjustment mode this is only the opening stock.", Required: true},
+            "unit": schema.StringAttribute{
+                Description: "Unit of measure of quantity: " + strings.Join(units, ", ") + ". Defaults to count.",
+                Optional:    true,
+                Validators:  []validator.String{oneOfValidator{values: units}},
+            },
+            "threshold": schema.Int64Attribute{Optional: true, DeprecationMessage: "Use reorder_level instead."},
+            "reorder_level": schema.Int64Attribute{Description: "Quantity at or below which the item needs reordering, in reorder_level_unit", Optional: true},
+            "reorder_level_unit": schema.StringAttribute{
+                Description: "Unit of reorder_level when it differs from unit. Must measure the same dimension (mass, volume or count) as unit.",
+                Optional:    true,
+                Validators:  []validator.String{oneOfValidator{values: units}},
+            },
+            "last_restocked": schema.StringAttribute{Description: "Date the item was last restocked (YYYY-MM-DD format)", Optional: true, Validators: []validator.String{dateValidator{}}},
+            "needs_reorder": schema.BoolAttribute{Description: "Whether current_quantity is at or below reorder_level after converting units", Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}},
+            "quantity_mode": schema.StringAttribute{
+                Description: "How quantity is managed: absolute sets stock to quantity on every apply; adjustment sets the opening stock once and then records adjustments. Switching an existing item between modes keeps its current stock. Defaults to absolute.",
+                Optional:    true,
+                Computed:    true,
+                Default:     stringdefault.StaticString(quantityModeAbsolute),
+                Validators:  []validator.String{oneOfValidator{values: []string{quantityModeAbsolute, quantityModeAdjustment}}},
+            },
+            "ignore_quantity_drift": schema.BoolAttribute{
+                Description: "In absolute mode, ignore stock changes made outside Terraform, such as POS sales. quantity is only sent when it changes in configuration.",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+            },
+            "adjustments": schema.ListNestedAttribute{
+                Description: "Stock events recorded in adjustment mode. The list is append-only: new entries are recorded on apply and existing entries cannot be changed or removed.",
+                Optional:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "type": schema.StringAttribute{
+                            Description: "Event type: " + strings.Join(inventoryAdjustmentTypes, ", "),
+                            Required:    true,
+                            Validators:  []validator.String{oneOfValidator{values: inventoryAdjustmentTypes}},
+                        },
+                        "quantity": schema.Int64Attribute{Description: "Quantity moved, always positive", Required: true},
+                        "unit": schema.StringAttribute{
+                            Description: "Unit of quantity when it differs from the item's unit",
+                            Optional:    true,
+                            Validators:  []validator.String{oneOfValidator{values: units}},
+                        },
+                        "reason": schema.StringAttribute{Optional: true},
+                    },
+                },
+            },
+            "current_quantity": schema.Int64Attribute{Description: "Stock on hand reported by the API, including sales and adjustments", Computed: true},
         },
     }
 }
@@ -46,17 +141,195 @@ func (r *inventoryResource) Configure(_ context.Context, req resource.ConfigureR
     r.client = client
 }
 
+func (r *inventoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config inventoryResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() { return }
+
+    if !config.Quantity.IsUnknown() && config.Quantity.ValueInt64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("quantity"), "Invalid Quantity", "quantity cannot be negative.")
+    }
+    if !config.Threshold.IsNull() && !config.ReorderLevel.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("reorder_level"), "Conflicting Reorder Level", "threshold cannot be set together with reorder_level; use reorder_level.")
+    }
+    if !config.ReorderLevel.IsNull() && !config.ReorderLevel.IsUnknown() && config.ReorderLevel.ValueInt64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("reorder_level"), "Invalid Reorder Level", "reorder_level cannot be negative.")
+    }
+    resp.Diagnostics.Append(validateInventoryAdjustments(ctx, config)...)
+    if config.Unit.IsUnknown() || config.ReorderLevelUnit.IsUnknown() || config.ReorderLevelUnit.IsNull() { return }
+    if _, err := convertUnits(0, config.ReorderLevelUnit.ValueString(), inventoryUnitOf(config)); err != nil {
+        resp.Diagnostics.AddAttributeError(path.Root("reorder_level_unit"), "Incompatible Units", fmt.Sprintf("reorder_level_unit does not match unit: %s", err))
+    }
+}
+
+func validateInventoryAdjustments(ctx context.Context, config inventoryResourceModel) diag.Diagnostics {
+    var diags diag.Diagnostics
+    adjustmentMode := config.QuantityMode.ValueString() == quantityModeAdjustment
+    if !config.QuantityMode.IsUnknown() && !adjustmentMode && !config.Adjustments.IsNull() {
+        diags.AddAttributeError(path.Root("adjustments"), "Adjustments Require Adjustment Mode", "adjustments can only be set when quantity_mode is adjustment.")
+    }
+    if !config.QuantityMode.IsUnknown() && adjustmentMode && !config.IgnoreDrift.IsNull() {
+        diags.AddAttributeError(path.Root("ignore_quantity_drift"), "Conflicting Quantity Settings", "ignore_quantity_drift only applies to absolute mode; adjustment mode never resets stock.")
+    }
+    if config.Adjustments.IsNull() || config.Adjustments.IsUnknown() { return diags }
+
+    var adjustments []inventoryAdjustmentModel
+    diags.Append(config.Adjustments.ElementsAs(ctx, &adjustments, false)...)
+    for i, adjustment := range adjustments {
+        at := path.Root("adjustments").AtListIndex(i)
+        if !adjustment.Quantity.IsUnknown() && adjustment.Quantity.ValueInt64() <= 0 {
+            diags.AddAttributeError(at.AtName("quantity"), "Invalid Adjustment Quantity", "Adjustment quantity must be greater than zero; the type sets the direction.")
+        }
+        if adjustment.Unit.IsNull() || adjustment.Unit.IsUnknown() || config.Unit.IsUnknown() { continue }
+        if _, err := convertUnits(0, adjustment.Unit.ValueString(), inventoryUnitOf(config)); err != nil {
+            diags.AddAttributeError(at.AtName("unit"), "Incompatible Units", fmt.Sprintf("Adjustment unit does not match unit: %s", err))
+        }
+    }
+    return diags
+}
+
+// ModifyPlan keeps adjustments append-only and stops quantity from being
+// changed once an item is managed by adjustments. An item switching to
+// adjustment mode keeps its stock, so its quantity is not checked.
+// needs_reorder is only recomputed when stock or the reorder level can change.
+func (r *inventoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() { return }
+    var plan, state inventoryResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() { return }
+
+    if !plan.Quantity.Equal(state.Quantity) || !plan.Unit.Equal(state.Unit) || !plan.reorderLevel().Equal(state.reorderLevel()) ||
+        !plan.ReorderLevelUnit.Equal(state.ReorderLevelUnit) || !plan.QuantityMode.Equal(state.QuantityMode) ||
+        !plan.IgnoreDrift.Equal(state.IgnoreDrift) || !plan.Adjustments.Equal(state.Adjustments) {
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("needs_reorder"), types.BoolUnknown())...)
+    }
+    if plan.QuantityMode.ValueString() == quantityModeAbsolute && state.QuantityMode.ValueString() == quantityModeAdjustment &&
+        !plan.IgnoreDrift.ValueBool() && !plan.Quantity.IsUnknown() && !plan.Quantity.Equal(state.CurrentQuantity) {
+        resp.Diagnostics.AddAttributeWarning(path.Root("quantity"), "Stock Will Be Reset On The Next Apply",
+            fmt.Sprintf("Switching to absolute mode keeps the current stock of %d, but quantity is %d. Unless quantity is updated or ignore_quantity_drift is set, the next apply resets stock to quantity.",
+                state.CurrentQuantity.ValueInt64(), plan.Quantity.ValueInt64()))
+    }
+    if plan.QuantityMode.ValueString() != quantityModeAdjustment { return }
+
+    switching := state.QuantityMode.ValueString() != quantityModeAdjustment
+    if !switching && !plan.Quantity.IsUnknown() && !plan.Quantity.Equal(state.Quantity) {
+        resp.Diagnostics.AddAttributeError(path.Root("quantity"), "Quantity Is Opening Stock",
+            "In adjustment mode quantity only sets the opening stock. Record stock changes by appending to adjustments instead.")
+    }
+    if plan.Adjustments.IsUnknown() { return }
+    prior, planned := state.Adjustments.Elements(), plan.Adjustments.Elements()
+    if len(planned) < len(prior) {
+        resp.Diagnostics.AddAttributeError(path.Root("adjustments"), "Adjustments Are Append-Only",
+            fmt.Sprintf("%d recorded adjustments cannot be removed; the plan only lists %d.", len(prior), len(planned)))
+        return
+    }
+    for i := range prior {
+        if !planned[i].Equal(prior[i]) {
+            resp.Diagnostics.AddAttributeError(path.Root("adjustments").AtListIndex(i), "Adjustments Are Append-Only",
+                "Recorded adjustments cannot be changed. Append a correcting adjustment instead.")
+        }
+    }
+}
+
+// checkCatalog reports an error when the SKU is not in the product catalog,
+// or when unit measures a different dimension than the catalog unit. It runs
+// at apply rather than plan time so that a product created in the same apply
+// is already in the catalog.
+func (r *inventoryResource) checkCatalog(plan inventoryResourceModel) diag.Diagnostics {
+    var diags diag.Diagnostics
+    sku := plan.ItemSKU.ValueString()
+    product, err := findProduct(r.client, sku)
+    if err != nil {
+        diags.AddError("Client Error", fmt.Sprintf("Unable to look up product SKU %q: %s", sku, err))
+        return diags
+    }
+    if product == nil {
+        diags.AddAttributeError(path.Root("item_sku"), "Unknown SKU",
+            fmt.Sprintf("SKU %q is not in the product catalog. Add it with a starbucks_product resource first.", sku))
+        return diags
+    }
+    if catalogUnit, ok := product["unit"].(string); ok {
+        if _, err := convertUnits(0, inventoryUnitOf(plan), catalogUnit); err != nil {
+            diags.AddAttributeError(path.Root("unit"), "Incompatible Units",
+                fmt.Sprintf("unit does not match the catalog unit of SKU %q: %s", sku, err))
+        }
+    }
+    return diags
+}
+
+// inventoryUnitOf returns the unit of an inventory item, defaulting to count.
+func inventoryUnitOf(m inventoryResourceModel) string {
+    if m.Unit.IsNull() || m.Unit.ValueString() == "" { return "count" }
+    return m.Unit.ValueString()
+}
+
+// reorderLevel returns the configured reorder level, honouring the
+// deprecated threshold attribute.
+func (m *inventoryResourceModel) reorderLevel() types.Int64 {
+    if !m.ReorderLevel.IsNull() { return m.ReorderLevel }
+    return m.Threshold
+}
+
+// setNeedsReorder compares stock on hand with the reorder level.
+func (m *inventoryResourceModel) setNeedsReorder() {
+    level := m.reorderLevel()
+    m.NeedsReorder = types.BoolValue(false)
+    if level.IsNull() || level.IsUnknown() { return }
+    quantity := m.Quantity
+    if !m.CurrentQuantity.IsNull() && !m.CurrentQuantity.IsUnknown() { quantity = m.CurrentQuantity }
+    m.NeedsReorder = types.BoolValue(needsReorder(quantity.ValueInt64(), inventoryUnitOf(*m), level.ValueInt64(), m.ReorderLevelUnit.ValueString()))
+}
+
+// needsReorder reports whether quantity, in unit, is at or below level, in
+// levelUnit. An empty levelUnit means unit. Levels in an incompatible unit
+// never need reordering.
+func needsReorder(quantity int64, unit string, level int64, levelUnit string) bool {
+    if levelUnit == "" { levelUnit = unit }
+    converted, err := convertUnits(float64(level), levelUnit, unit)
+    if err != nil { return false }
+    return float64(quantity) <= converted
+}
+
+// requestBody builds the inventory request. quantity is left out when
+// includeQuantity is false so that stock changed by sales is not reset.
+func (m *inventoryResourceModel) requestBody(includeQuantity bool) map[string]interface{} {
+    body := map[string]interface{}{
+        "store_id":           m.StoreID.ValueString(),
+        "item_sku":           m.ItemSKU.ValueString(),
+        "unit":               inventoryUnitOf(*m),
+        "item_name":          nil,
+        "item_type":          nil,
+        "reorder_level":      nil,
+        "reorder_level_unit": nil,
+        "last_restocked":     nil,
+    }
+    if includeQuantity { body["quantity"] = m.Quantity.ValueInt64() }
+    if !m.ItemName.IsNull() { body["item_name"] = m.ItemName.ValueString() }
+    if !m.ItemType.IsNull() { body["item_type"] = m.ItemType.ValueString() }
+    if level := m.reorderLevel(); !level.IsNull() { body["reorder_level"] = level.ValueInt64() }
+    if !m.ReorderLevelUnit.IsNull() { body["reorder_level_unit"] = m.ReorderLevelUnit.ValueString() }
+    if !m.LastRestocked.IsNull() { body["last_restocked"] = m.LastRestocked.ValueString() }
+    return body
+}
+
 func (r *inventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan inventoryResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() { return }
+    resp.Diagnostics.Append(r.checkCatalog(plan)...)
+    if resp.Diagnostics.HasError() { return }
 
-    body := map[string]interface{}{"store_id": plan.StoreID.ValueString(), "item_sku": plan.ItemSKU.ValueString(), "quantity": plan.Quantity.ValueInt64()}
-    respBody, err := r.client.DoRequest("POST", "/inventory", body)
+    respBody, err := r.client.DoRequest("POST", "/inventory", plan.requestBody(true))
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create inventory item: %s", err)); return }
     var result map[string]interface{}
     if err := json.Unmarshal(respBody, &result); err != nil { resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err)); return }
     if id, ok := result["id"].(string); ok { plan.ID = types.StringValue(id) }
+    plan.CurrentQuantity = plan.Quantity
+    if q, ok := result["quantity"].(float64); ok { plan.CurrentQuantity = types.Int64Value(int64(q)) }
+
+    resp.Diagnostics.Append(r.recordAdjustments(ctx, &plan, 0)...)
+    plan.setNeedsReorder()
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -68,20 +341,90 @@ func (r *inventoryResource) Read(ctx context.Context, req resource.ReadRequest,
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory item: %s", err)); return }
     var result map[string]interface{}
     if err := json.Unmarshal(respBody, &result); err != nil { resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err)); return }
-    if q, ok := result["quantity"].(float64); ok { state.Quantity = types.Int64Value(int64(q)) }
+    if q, ok := result["quantity"].(float64); ok {
+        state.CurrentQuantity = types.Int64Value(int64(q))
+        if state.QuantityMode.ValueString() != quantityModeAdjustment && !state.IgnoreDrift.ValueBool() { state.Quantity = types.Int64Value(int64(q)) }
+    }
+    if v, ok := result["item_name"].(string); ok { state.ItemName = types.StringValue(v) }
+    if v, ok := result["item_type"].(string); ok { state.ItemType = types.StringValue(v) }
+    if v, ok := result["unit"].(string); ok && (!state.Unit.IsNull() || v != "count") { state.Unit = types.StringValue(v) }
+    if v, ok := result["reorder_level"].(float64); ok {
+        if state.Threshold.IsNull() { state.ReorderLevel = types.Int64Value(int64(v)) } else { state.Threshold = types.Int64Value(int64(v)) }
+    }
+    if v, ok := result["reorder_level_unit"].(string); ok && !state.ReorderLevelUnit.IsNull() { state.ReorderLevelUnit = types.StringValue(v) }
+    if v, ok := result["last_restocked"].(string); ok { state.LastRestocked = types.StringValue(v) }
+    state.setNeedsReorder()
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *inventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-    var plan inventoryResourceModel
+    var plan, state inventoryResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
     if resp.Diagnostics.HasError() { return }
-    body := map[string]interface{}{"quantity": plan.Quantity.ValueInt64()}
-    _, err := r.client.DoRequest("PUT", "/inventory/"+plan.ID.ValueString(), body)
+    if !plan.Unit.Equal(state.Unit) {
+        resp.Diagnostics.Append(r.checkCatalog(plan)...)
+        if resp.Diagnostics.HasError() { return }
+    }
+
+    // Only an absolute item whose quantity changed in configuration, or which
+    // does not ignore drift, has its stock reset. An item switching modes
+    // keeps the stock the API reports: coming from adjustment mode, quantity
+    // is only the stale opening stock.
+    switching := !plan.QuantityMode.Equal(state.QuantityMode)
+    includeQuantity := plan.QuantityMode.ValueString() == quantityModeAbsolute && !switching && (!plan.IgnoreDrift.ValueBool() || !plan.Quantity.Equal(state.Quantity))
+    respBody, err := r.client.DoRequest("PUT", "/inventory/"+plan.ID.ValueString(), plan.requestBody(includeQuantity))
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update inventory item: %s", err)); return }
+    plan.CurrentQuantity = state.CurrentQuantity
+    if includeQuantity { plan.CurrentQuantity = plan.Quantity }
+    var result map[string]interface{}
+    if json.Unmarshal(respBody, &result) == nil {
+        if q, ok := result["quantity"].(float64); ok { plan.CurrentQuantity = types.Int64Value(int64(q)) }
+    }
+
+    resp.Diagnostics.Append(r.recordAdjustments(ctx, &plan, len(state.Adjustments.Elements()))...)
+    plan.setNeedsReorder()
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// recordAdjustments posts the adjustments from index from onwards, converting
+// quantities to the item's unit, and tracks the resulting stock on hand. On
+// failure plan.Adjustments is cut back to the adjustments actually recorded,
+// so the rest are retried on the next apply.
+func (r *inventoryResource) recordAdjustments(ctx context.Context, plan *inventoryResourceModel, from int) diag.Diagnostics {
+    var diags diag.Diagnostics
+    if plan.Adjustments.IsNull() { return diags }
+    var adjustments []inventoryAdjustmentModel
+    diags.Append(plan.Adjustments.ElementsAs(ctx, &adjustments, false)...)
+    if diags.HasError() { return diags }
+
+    unit := inventoryUnitOf(*plan)
+    recorded := func(count int) {
+        value, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: inventoryAdjustmentAttrTypes}, adjustments[:count])
+        diags.Append(d...)
+        plan.Adjustments = value
+    }
+    for i := from; i < len(adjustments); i++ {
+        adjustment := adjustments[i]
+        quantity := float64(adjustment.Quantity.ValueInt64())
+        if !adjustment.Unit.IsNull() {
+            converted, err := convertUnits(quantity, adjustment.Unit.ValueString(), unit)
+            if err != nil { diags.AddAttributeError(path.Root("adjustments").AtListIndex(i).AtName("unit"), "Incompatible Units", err.Error()); recorded(i); return diags }
+            quantity = converted
+        }
+        body := map[string]interface{}{"type": adjustment.Type.ValueString(), "quantity": quantity, "unit": unit}
+        if !adjustment.Reason.IsNull() { body["reason"] = adjustment.Reason.ValueString() }
+
+        respBody, err := r.client.DoRequest("POST", "/inventory/"+plan.ID.ValueString()+"/adjustments", body)
+        if err != nil { diags.AddError("Client Error", fmt.Sprintf("Unable to record inventory adjustment %d: %s", i, err)); recorded(i); return diags }
+        var result map[string]interface{}
+        if json.Unmarshal(respBody, &result) == nil {
+            if q, ok := result["quantity_after"].(float64); ok { plan.CurrentQuantity = types.Int64Value(int64(q)) }
+        }
+    }
+    return diags
+}
+
 func (r *inventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
     var state inventoryResourceModel
     resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -0,0 +1,395 @@
+package main
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &inventoryTransferResource{}
+var _ resource.ResourceWithImportState = &inventoryTransferResource{}
+var _ resource.ResourceWithValidateConfig = &inventoryTransferResource{}
+var _ resource.ResourceWithModifyPlan = &inventoryTransferResource{}
+
+const (
+    transferStatusPending   = "pending"
+    transferStatusCompleted = "completed"
+    transferStatusCancelled = "cancelled"
+)
+
+type inventoryTransferResource struct {
+    client *StarbucksClient
+}
+
+type inventoryTransferResourceModel struct {
+    ID                 types.String `tfsdk:"id"`
+    SourceStoreID      types.String `tfsdk:"source_store_id"`
+    DestinationStoreID types.String `tfsdk:"destination_store_id"`
+    Lines              types.List   `tfsdk:"lines"`
+    Reason             types.String `tfsdk:"reason"`
+    Status             types.String `tfsdk:"status"`
+    RequestedAt        types.String `tfsdk:"requested_at"`
+    CompletedAt        types.String `tfsdk:"completed_at"`
+}
+
+type inventoryTransferLineModel struct {
+    ItemSKU  types.String `tfsdk:"item_sku"`
+    Quantity types.Int64  `tfsdk:"quantity"`
+    Unit     types.String `tfsdk:"unit"`
+}
+
+var inventoryTransferLineAttrTypes = map[string]attr.Type{
+    "item_sku": types.StringType,
+    "quantity": types.Int64Type,
+    "unit":     types.StringType,
+}
+
+func NewInventoryTransferResource() resource.Resource {
+    return &inventoryTransferResource{}
+}
+
+func (r *inventoryTransferResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_inventory_transfer"
+}
+
+func (r *inventoryTransferResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    units := inventoryUnitNames()
+    resp.Schema = schema.Schema{
+        Description: "Moves stock from one store to another. All lines are transferred atomically. A transfer cannot be changed once created; destroying it cancels the transfer if it has not completed.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the transfer",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "source_store_id": schema.StringAttribute{
+                Description: "ID of the store the stock is taken from",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "destination_store_id": schema.StringAttribute{
+                Description: "ID of the store receiving the stock",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "lines": schema.ListNestedAttribute{
+                Description: "SKUs and quantities to transfer. The source store must have enough stock of each SKU at plan time.",
+                Required:    true,
+                PlanModifiers: []planmodifier.List{
+                    listplanmodifier.RequiresReplace(),
+                },
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "item_sku": schema.StringAttribute{
+                            Description: "SKU to transfer",
+                            Required:    true,
+                        },
+                        "quantity": schema.Int64Attribute{
+                            Description: "Quantity to transfer, in unit",
+                            Required:    true,
+                        },
+                        "unit": schema.StringAttribute{
+                            Description: "Unit of quantity. Defaults to the source store's unit for the SKU.",
+                            Optional:    true,
+                            Validators:  []validator.String{oneOfValidator{values: units}},
+                        },
+                    },
+                },
+            },
+            "reason": schema.StringAttribute{
+                Description: "Why the stock is moved",
+                Optional:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "status": schema.StringAttribute{
+                Description: "Transfer status: pending, in_transit, completed or cancelled",
+                Computed:    true,
+            },
+            "requested_at": schema.StringAttribute{
+                Description: "Time the transfer was requested (RFC3339)",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "completed_at": schema.StringAttribute{
+                Description: "Time the stock arrived at the destination store (RFC3339)",
+                Computed:    true,
+            },
+        },
+    }
+}
+
+func (r *inventoryTransferResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *inventoryTransferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.SourceStoreID.IsUnknown() && !config.DestinationStoreID.IsUnknown() && config.SourceStoreID.Equal(config.DestinationStoreID) {
+        resp.Diagnostics.AddAttributeError(path.Root("destination_store_id"), "Invalid Destination", "destination_store_id must differ from source_store_id.")
+    }
+
+    if config.Lines.IsNull() || config.Lines.IsUnknown() {
+        return
+    }
+    var lines []inventoryTransferLineModel
+    resp.Diagnostics.Append(config.Lines.ElementsAs(ctx, &lines, false)...)
+    if len(lines) == 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("lines"), "Missing Lines", "A transfer needs at least one line.")
+    }
+    for i, line := range lines {
+        if !line.Quantity.IsUnknown() && line.Quantity.ValueInt64() <= 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("lines").AtListIndex(i).AtName("quantity"), "Invalid Quantity", "Transfer quantity must be greater than zero.")
+        }
+    }
+}
+
+// ModifyPlan checks that the source store has enough stock for a new
+// transfer, including one replacing an existing transfer because its lines or
+// source store changed. Lines for the same SKU are added up.
+func (r *inventoryTransferResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() || r.client == nil {
+        return
+    }
+    var plan inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() || plan.SourceStoreID.IsUnknown() || plan.Lines.IsUnknown() {
+        return
+    }
+    if !req.State.Raw.IsNull() {
+        var state inventoryTransferResourceModel
+        resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+        if resp.Diagnostics.HasError() || (plan.Lines.Equal(state.Lines) && plan.SourceStoreID.Equal(state.SourceStoreID)) {
+            return
+        }
+    }
+    var lines []inventoryTransferLineModel
+    resp.Diagnostics.Append(plan.Lines.ElementsAs(ctx, &lines, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    inventory, err := r.client.ListInventory(InventoryFilter{StoreID: plan.SourceStoreID.ValueString()})
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source store inventory: %s", err))
+        return
+    }
+    type stock struct {
+        quantity float64
+        unit     string
+    }
+    available := map[string]stock{}
+    for _, item := range inventory {
+        sku, _ := item["item_sku"].(string)
+        quantity, _ := item["quantity"].(float64)
+        unit, _ := item["unit"].(string)
+        if unit == "" {
+            unit = "count"
+        }
+        available[sku] = stock{quantity: quantity, unit: unit}
+    }
+
+    requested := map[string]float64{}
+    for i, line := range lines {
+        if line.ItemSKU.IsUnknown() || line.Quantity.IsUnknown() || line.Unit.IsUnknown() {
+            return
+        }
+        sku := line.ItemSKU.ValueString()
+        source, ok := available[sku]
+        if !ok {
+            resp.Diagnostics.AddAttributeError(path.Root("lines").AtListIndex(i).AtName("item_sku"), "Insufficient Stock",
+                fmt.Sprintf("Source store %s does not stock SKU %q.", plan.SourceStoreID.ValueString(), sku))
+            continue
+        }
+        quantity := float64(line.Quantity.ValueInt64())
+        if !line.Unit.IsNull() {
+            converted, err := convertUnits(quantity, line.Unit.ValueString(), source.unit)
+            if err != nil {
+                resp.Diagnostics.AddAttributeError(path.Root("lines").AtListIndex(i).AtName("unit"), "Incompatible Units", err.Error())
+                continue
+            }
+            quantity = converted
+        }
+        requested[sku] += quantity
+        if requested[sku] > source.quantity {
+            resp.Diagnostics.AddAttributeError(path.Root("lines").AtListIndex(i), "Insufficient Stock",
+                fmt.Sprintf("Source store %s has %g %s of SKU %q, but %g %s are requested.", plan.SourceStoreID.ValueString(), source.quantity, source.unit, sku, requested[sku], source.unit))
+        }
+    }
+}
+
+func (m *inventoryTransferResourceModel) setStatus(result map[string]interface{}) {
+    if val, ok := result["status"].(string); ok {
+        m.Status = types.StringValue(val)
+    }
+    if val, ok := result["requested_at"].(string); ok {
+        m.RequestedAt = types.StringValue(val)
+    }
+    m.CompletedAt = types.StringNull()
+    if val, ok := result["completed_at"].(string); ok {
+        m.CompletedAt = types.StringValue(val)
+    }
+}
+
+func (r *inventoryTransferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var lines []inventoryTransferLineModel
+    resp.Diagnostics.Append(plan.Lines.ElementsAs(ctx, &lines, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+    rawLines := make([]interface{}, 0, len(lines))
+    for _, line := range lines {
+        entry := map[string]interface{}{"item_sku": line.ItemSKU.ValueString(), "quantity": line.Quantity.ValueInt64()}
+        if !line.Unit.IsNull() {
+            entry["unit"] = line.Unit.ValueString()
+        }
+        rawLines = append(rawLines, entry)
+    }
+    requestBody := map[string]interface{}{
+        "source_store_id":      plan.SourceStoreID.ValueString(),
+        "destination_store_id": plan.DestinationStoreID.ValueString(),
+        "lines":                rawLines,
+    }
+    if !plan.Reason.IsNull() {
+        requestBody["reason"] = plan.Reason.ValueString()
+    }
+
+    result, err := r.client.CreateInventoryTransfer(requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create inventory transfer: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+    plan.Status = types.StringValue(transferStatusPending)
+    plan.RequestedAt = types.StringNull()
+    plan.setStatus(result)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *inventoryTransferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.GetInventoryTransfer(state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory transfer: %s", err))
+        return
+    }
+
+    if val, ok := result["source_store_id"].(string); ok {
+        state.SourceStoreID = types.StringValue(val)
+    }
+    if val, ok := result["destination_store_id"].(string); ok {
+        state.DestinationStoreID = types.StringValue(val)
+    }
+    if val, ok := result["reason"].(string); ok {
+        state.Reason = types.StringValue(val)
+    }
+    if state.Lines.IsNull() {
+        if raw, ok := result["lines"].([]interface{}); ok {
+            lines := make([]inventoryTransferLineModel, 0, len(raw))
+            for _, e := range raw {
+                entry, ok := e.(map[string]interface{})
+                if !ok {
+                    continue
+                }
+                line := inventoryTransferLineModel{ItemSKU: types.StringNull(), Quantity: types.Int64Null(), Unit: types.StringNull()}
+                if val, ok := entry["item_sku"].(string); ok {
+                    line.ItemSKU = types.StringValue(val)
+                }
+                if val, ok := entry["quantity"].(float64); ok {
+                    line.Quantity = types.Int64Value(int64(val))
+                }
+                if val, ok := entry["unit"].(string); ok {
+                    line.Unit = types.StringValue(val)
+                }
+                lines = append(lines, line)
+            }
+            value, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: inventoryTransferLineAttrTypes}, lines)
+            resp.Diagnostics.Append(diags...)
+            state.Lines = value
+        }
+    }
+    state.setStatus(result)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with configuration changes because every
+// configurable attribute requires replacement.
+func (r *inventoryTransferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *inventoryTransferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state inventoryTransferResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    switch state.Status.ValueString() {
+    case transferStatusCompleted, transferStatusCancelled:
+        resp.Diagnostics.AddWarning("Transfer Not Reverted",
+            fmt.Sprintf("Inventory transfer %s is %s and was only removed from Terraform state. Create a transfer in the opposite direction to move the stock back.", state.ID.ValueString(), state.Status.ValueString()))
+        return
+    }
+
+    if err := r.client.CancelInventoryTransfer(state.ID.ValueString()); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel inventory transfer: %s", err))
+        return
+    }
+}
+
+func (r *inventoryTransferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
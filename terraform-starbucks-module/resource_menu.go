@@ -0,0 +1,353 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &menuResource{}
+var _ resource.ResourceWithImportState = &menuResource{}
+var _ resource.ResourceWithValidateConfig = &menuResource{}
+
+type menuResource struct {
+    client *StarbucksClient
+}
+
+type menuResourceModel struct {
+    ID              types.String `tfsdk:"id"`
+    Name            types.String `tfsdk:"name"`
+    EffectiveFrom   types.String `tfsdk:"effective_from"`
+    EffectiveTo     types.String `tfsdk:"effective_to"`
+    MenuItemIDs     types.Set    `tfsdk:"menu_item_ids"`
+    CategoryOrder   types.List   `tfsdk:"category_order"`
+    StoreIDs        types.Set    `tfsdk:"store_ids"`
+    Regions         types.Set    `tfsdk:"regions"`
+    Version         types.Int64  `tfsdk:"version"`
+    PreviousVersion types.Int64  `tfsdk:"previous_version"`
+    PublishedAt     types.String `tfsdk:"published_at"`
+}
+
+func NewMenuResource() resource.Resource {
+    return &menuResource{}
+}
+
+func (r *menuResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_menu"
+}
+
+func (r *menuResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a versioned menu grouping menu items for a set of stores or regions. Every change is saved as a draft and published as a new version; the previous version is retained for rollback.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the menu",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the menu, e.g. Fall 2024",
+                Required:    true,
+            },
+            "effective_from": schema.StringAttribute{
+                Description: "First day the menu is in effect (YYYY-MM-DD format)",
+                Required:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "effective_to": schema.StringAttribute{
+                Description: "Last day the menu is in effect (YYYY-MM-DD format). Omit for an open-ended menu.",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "menu_item_ids": schema.SetAttribute{
+                Description: "IDs of the menu items on the menu",
+                Required:    true,
+                ElementType: types.StringType,
+            },
+            "category_order": schema.ListAttribute{
+                Description: "Order in which categories are displayed. Categories not listed follow in alphabetical order.",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "store_ids": schema.SetAttribute{
+                Description: "IDs of the stores the menu is assigned to",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "regions": schema.SetAttribute{
+                Description: "Regions the menu is assigned to",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "version": schema.Int64Attribute{
+                Description: "Currently published version of the menu",
+                Computed:    true,
+            },
+            "previous_version": schema.Int64Attribute{
+                Description: "Version published before the current one, available for rollback",
+                Computed:    true,
+            },
+            "published_at": schema.StringAttribute{
+                Description: "Time the current version was published (RFC3339)",
+                Computed:    true,
+            },
+        },
+    }
+}
+
+func (r *menuResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *menuResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config menuResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if config.StoreIDs.IsNull() && config.Regions.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("store_ids"), "Missing Assignment", "A menu must be assigned with store_ids, regions or both.")
+    }
+
+    if !config.CategoryOrder.IsNull() && !config.CategoryOrder.IsUnknown() {
+        var categories []types.String
+        resp.Diagnostics.Append(config.CategoryOrder.ElementsAs(ctx, &categories, false)...)
+        seen := map[string]bool{}
+        for i, category := range categories {
+            if category.IsUnknown() || category.IsNull() {
+                continue
+            }
+            if seen[category.ValueString()] {
+                resp.Diagnostics.AddAttributeError(path.Root("category_order").AtListIndex(i), "Duplicate Category",
+                    fmt.Sprintf("Category %q is listed more than once.", category.ValueString()))
+            }
+            seen[category.ValueString()] = true
+        }
+    }
+
+    if config.EffectiveFrom.IsUnknown() || config.EffectiveTo.IsUnknown() || config.EffectiveTo.IsNull() {
+        return
+    }
+    from, fromErr := time.Parse(dateLayout, config.EffectiveFrom.ValueString())
+    to, toErr := time.Parse(dateLayout, config.EffectiveTo.ValueString())
+    if fromErr == nil && toErr == nil && to.Before(from) {
+        resp.Diagnostics.AddAttributeError(path.Root("effective_to"), "Invalid Effective Dates",
+            fmt.Sprintf("effective_to %s is before effective_from %s", config.EffectiveTo.ValueString(), config.EffectiveFrom.ValueString()))
+    }
+}
+
+// expandStrings converts a string set or list to a slice for a request body.
+// Null collections are sent as null so the API clears them.
+func expandStrings(ctx context.Context, value interface {
+    IsNull() bool
+    ElementsAs(context.Context, interface{}, bool) diag.Diagnostics
+}) (interface{}, diag.Diagnostics) {
+    if value.IsNull() {
+        return nil, nil
+    }
+    values := []string{}
+    diags := value.ElementsAs(ctx, &values, false)
+    return values, diags
+}
+
+// flattenStrings converts a string array from the API. Empty arrays keep a
+// null prior value null.
+func flattenStrings(raw interface{}, priorNull bool) ([]string, bool) {
+    items, ok := raw.([]interface{})
+    if !ok || (len(items) == 0 && priorNull) {
+        return nil, false
+    }
+    values := []string{}
+    for _, item := range items {
+        if s, ok := item.(string); ok {
+            values = append(values, s)
+        }
+    }
+    return values, true
+}
+
+func (r *menuResource) requestBody(ctx context.Context, plan menuResourceModel) (map[string]interface{}, diag.Diagnostics) {
+    var diags diag.Diagnostics
+    body := map[string]interface{}{
+        "name":           plan.Name.ValueString(),
+        "effective_from": plan.EffectiveFrom.ValueString(),
+        "effective_to":   nil,
+    }
+    if !plan.EffectiveTo.IsNull() {
+        body["effective_to"] = plan.EffectiveTo.ValueString()
+    }
+    var d diag.Diagnostics
+    body["menu_item_ids"], d = expandStrings(ctx, plan.MenuItemIDs)
+    diags.Append(d...)
+    body["category_order"], d = expandStrings(ctx, plan.CategoryOrder)
+    diags.Append(d...)
+    body["store_ids"], d = expandStrings(ctx, plan.StoreIDs)
+    diags.Append(d...)
+    body["regions"], d = expandStrings(ctx, plan.Regions)
+    diags.Append(d...)
+    return body, diags
+}
+
+// setVersion records the version details returned when a menu is published.
+func (m *menuResourceModel) setVersion(result map[string]interface{}) {
+    m.Version = types.Int64Null()
+    if val, ok := result["version"].(float64); ok {
+        m.Version = types.Int64Value(int64(val))
+    }
+    m.PreviousVersion = types.Int64Null()
+    if val, ok := result["previous_version"].(float64); ok {
+        m.PreviousVersion = types.Int64Value(int64(val))
+    }
+    m.PublishedAt = types.StringNull()
+    if val, ok := result["published_at"].(string); ok {
+        m.PublishedAt = types.StringValue(val)
+    }
+}
+
+func (r *menuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan menuResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.CreateMenu(requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create menu: %s", err))
+        return
+    }
+    id, _ := result["id"].(string)
+    plan.ID = types.StringValue(id)
+
+    published, err := r.client.PublishMenu(id)
+    if err != nil {
+        // Record the draft so Terraform taints and replaces it rather than
+        // leaving an orphaned menu behind.
+        plan.setVersion(nil)
+        resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish menu: %s", err))
+        return
+    }
+    plan.setVersion(published)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *menuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state menuResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.GetMenu(state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read menu: %s", err))
+        return
+    }
+
+    if val, ok := result["name"].(string); ok {
+        state.Name = types.StringValue(val)
+    }
+    if val, ok := result["effective_from"].(string); ok {
+        state.EffectiveFrom = types.StringValue(val)
+    }
+    state.EffectiveTo = types.StringNull()
+    if val, ok := result["effective_to"].(string); ok {
+        state.EffectiveTo = types.StringValue(val)
+    }
+    sets := map[string]*types.Set{
+        "menu_item_ids": &state.MenuItemIDs,
+        "store_ids":     &state.StoreIDs,
+        "regions":       &state.Regions,
+    }
+    for key, target := range sets {
+        if values, ok := flattenStrings(result[key], target.IsNull()); ok {
+            value, diags := types.SetValueFrom(ctx, types.StringType, values)
+            resp.Diagnostics.Append(diags...)
+            *target = value
+        }
+    }
+    if values, ok := flattenStrings(result["category_order"], state.CategoryOrder.IsNull()); ok {
+        value, diags := types.ListValueFrom(ctx, types.StringType, values)
+        resp.Diagnostics.Append(diags...)
+        state.CategoryOrder = value
+    }
+    if resp.Diagnostics.HasError() {
+        return
+    }
+    state.setVersion(result)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *menuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan menuResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if err := r.client.UpdateMenuDraft(plan.ID.ValueString(), requestBody); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update menu draft: %s", err))
+        return
+    }
+
+    published, err := r.client.PublishMenu(plan.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish menu: %s", err))
+        return
+    }
+    plan.setVersion(published)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *menuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state menuResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if err := r.client.DeleteMenu(state.ID.ValueString()); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete menu: %s", err))
+        return
+    }
+}
+
+func (r *menuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
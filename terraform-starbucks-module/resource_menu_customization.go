@@ -0,0 +1,375 @@
+package main
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &menuCustomizationResource{}
+var _ resource.ResourceWithImportState = &menuCustomizationResource{}
+var _ resource.ResourceWithValidateConfig = &menuCustomizationResource{}
+
+type menuCustomizationResource struct {
+    client *StarbucksClient
+}
+
+type menuCustomizationResourceModel struct {
+    ID             types.String `tfsdk:"id"`
+    Name           types.String `tfsdk:"name"`
+    Choices        types.List   `tfsdk:"choices"`
+    DefaultChoices types.Set    `tfsdk:"default_choices"`
+    MinSelections  types.Int64  `tfsdk:"min_selections"`
+    MaxSelections  types.Int64  `tfsdk:"max_selections"`
+    MenuItemIDs    types.Set    `tfsdk:"menu_item_ids"`
+}
+
+type menuCustomizationChoiceModel struct {
+    Name     types.String  `tfsdk:"name"`
+    Upcharge types.Float64 `tfsdk:"upcharge"`
+}
+
+var menuCustomizationChoiceAttrTypes = map[string]attr.Type{
+    "name":     types.StringType,
+    "upcharge": types.Float64Type,
+}
+
+func NewMenuCustomizationResource() resource.Resource {
+    return &menuCustomizationResource{}
+}
+
+func (r *menuCustomizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_menu_customization"
+}
+
+func (r *menuCustomizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a group of customization options, such as milk alternatives, syrups or extra shots, offered on menu items.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the customization",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the option group, e.g. Milk",
+                Required:    true,
+            },
+            "choices": schema.ListNestedAttribute{
+                Description: "Choices in the order they are displayed. Names must be unique.",
+                Required:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "name": schema.StringAttribute{
+                            Description: "Name of the choice, e.g. Oat Milk",
+                            Required:    true,
+                        },
+                        "upcharge": schema.Float64Attribute{
+                            Description: "Price added when the choice is selected (USD). Defaults to 0.",
+                            Optional:    true,
+                            Computed:    true,
+                            Default:     float64default.StaticFloat64(0),
+                        },
+                    },
+                },
+            },
+            "default_choices": schema.SetAttribute{
+                Description: "Names of the choices selected by default",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "min_selections": schema.Int64Attribute{
+                Description: "Minimum number of choices a customer must select. Defaults to 0.",
+                Optional:    true,
+                Computed:    true,
+                Default:     int64default.StaticInt64(0),
+            },
+            "max_selections": schema.Int64Attribute{
+                Description: "Maximum number of choices a customer can select. Defaults to 1.",
+                Optional:    true,
+                Computed:    true,
+                Default:     int64default.StaticInt64(1),
+            },
+            "menu_item_ids": schema.SetAttribute{
+                Description: "IDs of the menu items offering this customization",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+        },
+    }
+}
+
+func (r *menuCustomizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *menuCustomizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config menuCustomizationResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    minKnown := !config.MinSelections.IsNull() && !config.MinSelections.IsUnknown()
+    maxKnown := !config.MaxSelections.IsNull() && !config.MaxSelections.IsUnknown()
+    minSelections, maxSelections := int64(0), int64(1)
+    if minKnown {
+        minSelections = config.MinSelections.ValueInt64()
+    }
+    if maxKnown {
+        maxSelections = config.MaxSelections.ValueInt64()
+    }
+    if minSelections < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("min_selections"), "Invalid Selections", "min_selections cannot be negative.")
+    }
+    if maxSelections < 1 {
+        resp.Diagnostics.AddAttributeError(path.Root("max_selections"), "Invalid Selections", "max_selections must be at least 1.")
+    }
+    if !config.MinSelections.IsUnknown() && !config.MaxSelections.IsUnknown() && minSelections > maxSelections {
+        resp.Diagnostics.AddAttributeError(path.Root("min_selections"), "Invalid Selections",
+            fmt.Sprintf("min_selections %d is greater than max_selections %d.", minSelections, maxSelections))
+    }
+
+    if config.Choices.IsNull() || config.Choices.IsUnknown() {
+        return
+    }
+    var choices []menuCustomizationChoiceModel
+    resp.Diagnostics.Append(config.Choices.ElementsAs(ctx, &choices, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    names := map[string]bool{}
+    namesKnown := true
+    for i, choice := range choices {
+        if !choice.Upcharge.IsNull() && !choice.Upcharge.IsUnknown() && choice.Upcharge.ValueFloat64() < 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("choices").AtListIndex(i).AtName("upcharge"), "Invalid Upcharge",
+                fmt.Sprintf("Upcharge of choice %q cannot be negative.", choice.Name.ValueString()))
+        }
+        if choice.Name.IsUnknown() {
+            namesKnown = false
+            continue
+        }
+        if names[choice.Name.ValueString()] {
+            resp.Diagnostics.AddAttributeError(path.Root("choices").AtListIndex(i).AtName("name"), "Duplicate Choice",
+                fmt.Sprintf("Choice %q is listed more than once.", choice.Name.ValueString()))
+        }
+        names[choice.Name.ValueString()] = true
+    }
+    if namesKnown && maxKnown && int(maxSelections) > len(choices) {
+        resp.Diagnostics.AddAttributeError(path.Root("max_selections"), "Invalid Selections",
+            fmt.Sprintf("max_selections %d is greater than the %d choices offered.", maxSelections, len(choices)))
+    }
+
+    if config.DefaultChoices.IsNull() || config.DefaultChoices.IsUnknown() || !namesKnown {
+        return
+    }
+    var defaults []types.String
+    resp.Diagnostics.Append(config.DefaultChoices.ElementsAs(ctx, &defaults, false)...)
+    defaultsKnown := true
+    for _, d := range defaults {
+        if d.IsUnknown() {
+            defaultsKnown = false
+            continue
+        }
+        if !names[d.ValueString()] {
+            resp.Diagnostics.AddAttributeError(path.Root("default_choices"), "Unknown Default Choice",
+                fmt.Sprintf("Default %q is not one of the choices.", d.ValueString()))
+        }
+    }
+    if defaultsKnown && !config.MinSelections.IsUnknown() && !config.MaxSelections.IsUnknown() {
+        count := int64(len(defaults))
+        if count < minSelections || count > maxSelections {
+            resp.Diagnostics.AddAttributeError(path.Root("default_choices"), "Invalid Default Choices",
+                fmt.Sprintf("%d default choices are selected, but between %d and %d selections are allowed.", count, minSelections, maxSelections))
+        }
+    }
+}
+
+func menuCustomizationsPath(customizationID string) string {
+    return "/menu_customizations/" + customizationID
+}
+
+func (r *menuCustomizationResource) requestBody(ctx context.Context, plan menuCustomizationResourceModel) (map[string]interface{}, diag.Diagnostics) {
+    var diags diag.Diagnostics
+    var choices []menuCustomizationChoiceModel
+    diags.Append(plan.Choices.ElementsAs(ctx, &choices, false)...)
+    rawChoices := make([]interface{}, 0, len(choices))
+    for _, choice := range choices {
+        rawChoices = append(rawChoices, map[string]interface{}{
+            "name":     choice.Name.ValueString(),
+            "upcharge": choice.Upcharge.ValueFloat64(),
+        })
+    }
+
+    body := map[string]interface{}{
+        "name":           plan.Name.ValueString(),
+        "choices":        rawChoices,
+        "min_selections": plan.MinSelections.ValueInt64(),
+        "max_selections": plan.MaxSelections.ValueInt64(),
+    }
+    var d diag.Diagnostics
+    body["default_choices"], d = expandStrings(ctx, plan.DefaultChoices)
+    diags.Append(d...)
+    body["menu_item_ids"], d = expandStrings(ctx, plan.MenuItemIDs)
+    diags.Append(d...)
+    return body, diags
+}
+
+func flattenMenuCustomizationChoices(ctx context.Context, raw []interface{}) (types.List, diag.Diagnostics) {
+    choices := make([]menuCustomizationChoiceModel, 0, len(raw))
+    for _, e := range raw {
+        entry, ok := e.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        choice := menuCustomizationChoiceModel{Name: types.StringNull(), Upcharge: types.Float64Value(0)}
+        if val, ok := entry["name"].(string); ok {
+            choice.Name = types.StringValue(val)
+        }
+        if val, ok := entry["upcharge"].(float64); ok {
+            choice.Upcharge = types.Float64Value(val)
+        }
+        choices = append(choices, choice)
+    }
+    return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: menuCustomizationChoiceAttrTypes}, choices)
+}
+
+func (r *menuCustomizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan menuCustomizationResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", "/menu_customizations", requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create menu customization: %s", err))
+        return
+    }
+    result, err := decodeObject(respBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *menuCustomizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state menuCustomizationResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", menuCustomizationsPath(state.ID.ValueString()), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read menu customization: %s", err))
+        return
+    }
+    result, err := decodeObject(respBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["name"].(string); ok {
+        state.Name = types.StringValue(val)
+    }
+    if val, ok := result["min_selections"].(float64); ok {
+        state.MinSelections = types.Int64Value(int64(val))
+    }
+    if val, ok := result["max_selections"].(float64); ok {
+        state.MaxSelections = types.Int64Value(int64(val))
+    }
+    if val, ok := result["choices"].([]interface{}); ok {
+        choices, diags := flattenMenuCustomizationChoices(ctx, val)
+        resp.Diagnostics.Append(diags...)
+        state.Choices = choices
+    }
+    if values, ok := flattenStrings(result["default_choices"], state.DefaultChoices.IsNull()); ok {
+        value, diags := types.SetValueFrom(ctx, types.StringType, values)
+        resp.Diagnostics.Append(diags...)
+        state.DefaultChoices = value
+    }
+    if values, ok := flattenStrings(result["menu_item_ids"], state.MenuItemIDs.IsNull()); ok {
+        value, diags := types.SetValueFrom(ctx, types.StringType, values)
+        resp.Diagnostics.Append(diags...)
+        state.MenuItemIDs = value
+    }
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *menuCustomizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan menuCustomizationResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", menuCustomizationsPath(plan.ID.ValueString()), requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update menu customization: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *menuCustomizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state menuCustomizationResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", menuCustomizationsPath(state.ID.ValueString()), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete menu customization: %s", err))
+        return
+    }
+}
+
+func (r *menuCustomizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,12 +4,34 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "sort"
+    "strings"
 
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+var _ resource.ResourceWithValidateConfig = &menuItemResource{}
+var _ resource.ResourceWithModifyPlan = &menuItemResource{}
+
+// drinkSizeVolumes maps standard drink sizes to their volume in fluid ounces.
+var drinkSizeVolumes = map[string]float64{
+    "short":  8,
+    "tall":   12,
+    "grande": 16,
+    "venti":  20,
+    "trenta": 30,
+}
+
+// allergenNames are the allergens that can be declared on a menu item.
+var allergenNames = []string{"milk", "eggs", "fish", "shellfish", "tree_nuts", "peanuts", "wheat", "soy", "sesame"}
+
 type menuItemResource struct {
     client *StarbucksClient
 }
@@ -24,6 +46,58 @@ type menuItemResourceModel struct {
     Description types.String  `tfsdk:"description"`
     IsAvailable types.Bool    `tfsdk:"is_available"`
     IsSeasonal  types.Bool    `tfsdk:"is_seasonal"`
+    Variants    types.Set     `tfsdk:"variants"`
+    Nutrition   types.Object  `tfsdk:"nutrition"`
+    Allergens   types.Set     `tfsdk:"allergens"`
+    Recipe      types.List    `tfsdk:"recipe"`
+}
+
+type menuItemIngredientModel struct {
+    Size     types.String  `tfsdk:"size"`
+    ItemSKU  types.String  `tfsdk:"item_sku"`
+    Quantity types.Float64 `tfsdk:"quantity"`
+    Unit     types.String  `tfsdk:"unit"`
+}
+
+var menuItemIngredientAttrTypes = map[string]attr.Type{
+    "size":     types.StringType,
+    "item_sku": types.StringType,
+    "quantity": types.Float64Type,
+    "unit":     types.StringType,
+}
+
+type menuItemNutritionModel struct {
+    Calories   types.Int64   `tfsdk:"calories"`
+    FatG       types.Float64 `tfsdk:"fat_g"`
+    SugarG     types.Float64 `tfsdk:"sugar_g"`
+    ProteinG   types.Float64 `tfsdk:"protein_g"`
+    CaffeineMg types.Int64   `tfsdk:"caffeine_mg"`
+    SodiumMg   types.Int64   `tfsdk:"sodium_mg"`
+}
+
+var menuItemNutritionAttrTypes = map[string]attr.Type{
+    "calories":    types.Int64Type,
+    "fat_g":       types.Float64Type,
+    "sugar_g":     types.Float64Type,
+    "protein_g":   types.Float64Type,
+    "caffeine_mg": types.Int64Type,
+    "sodium_mg":   types.Int64Type,
+}
+
+type menuItemVariantModel struct {
+    Size       types.String  `tfsdk:"size"`
+    VolumeOz   types.Float64 `tfsdk:"volume_oz"`
+    Price      types.Float64 `tfsdk:"price"`
+    Calories   types.Int64   `tfsdk:"calories"`
+    CaffeineMg types.Int64   `tfsdk:"caffeine_mg"`
+}
+
+var menuItemVariantAttrTypes = map[string]attr.Type{
+    "size":        types.StringType,
+    "volume_oz":   types.Float64Type,
+    "price":       types.Float64Type,
+    "calories":    types.Int64Type,
+    "caffeine_mg": types.Int64Type,
 }
 
 func NewMenuItemResource() resource.Resource { return &menuItemResource{} }
@@ -41,14 +115,376 @@ func (r *menuItemResource) Schema(_ context.Context, _ resource.SchemaRequest, r
             "category": schema.StringAttribute{Optional: true},
             "size": schema.StringAttribute{Optional: true},
             "price": schema.Float64Attribute{Optional: true},
-            "calories": schema.Int64Attribute{Optional: true},
+            "calories": schema.Int64Attribute{Optional: true, DeprecationMessage: "Use nutrition.calories instead."},
             "description": schema.StringAttribute{Optional: true},
-            "is_available": schema.BoolAttribute{Optional: true, Computed: true},
+            "is_available": schema.BoolAttribute{Description: "Whether the item is available chain-wide. Use starbucks_store_menu_item to override per store.", Optional: true, Computed: true},
             "is_seasonal": schema.BoolAttribute{Optional: true, Computed: true},
+            "variants": schema.SetNestedAttribute{
+                Description: "Per-size variants of the item. Conflicts with size and price. Sizes must be unique and prices must increase with volume.",
+                Optional:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "size": schema.StringAttribute{Description: "Size name, e.g. tall, grande or venti", Required: true},
+                        "volume_oz": schema.Float64Attribute{Description: "Volume in fluid ounces. Required for sizes other than short, tall, grande, venti and trenta.", Optional: true},
+                        "price": schema.Float64Attribute{Description: "Price of this size (USD)", Required: true},
+                        "calories": schema.Int64Attribute{Optional: true},
+                        "caffeine_mg": schema.Int64Attribute{Optional: true},
+                    },
+                },
+            },
+            "nutrition": schema.SingleNestedAttribute{
+                Description: "Nutrition facts per serving",
+                Optional:    true,
+                Attributes: map[string]schema.Attribute{
+                    "calories": schema.Int64Attribute{Optional: true},
+                    "fat_g": schema.Float64Attribute{Description: "Total fat in grams", Optional: true},
+                    "sugar_g": schema.Float64Attribute{Description: "Sugars in grams", Optional: true},
+                    "protein_g": schema.Float64Attribute{Description: "Protein in grams", Optional: true},
+                    "caffeine_mg": schema.Int64Attribute{Description: "Caffeine in milligrams", Optional: true},
+                    "sodium_mg": schema.Int64Attribute{Description: "Sodium in milligrams", Optional: true},
+                },
+            },
+            "allergens": schema.SetAttribute{
+                Description: "Allergens contained in the item: " + strings.Join(allergenNames, ", "),
+                Optional:    true,
+                ElementType: types.StringType,
+                Validators:  []validator.Set{allergensValidator{}},
+            },
+            "recipe": schema.ListNestedAttribute{
+                Description: "Inventory ingredients used to make one serving. Ingredients without a size apply to every size. An item_sku missing from the product catalog only produces a plan warning.",
+                Optional:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "size": schema.StringAttribute{Description: "Variant size the ingredient applies to. Omit for all sizes.", Optional: true},
+                        "item_sku": schema.StringAttribute{Description: "SKU of the inventory item used", Required: true},
+                        "quantity": schema.Float64Attribute{Description: "Quantity of the inventory item used per serving, in unit", Required: true},
+                        "unit": schema.StringAttribute{
+                            Description: "Unit quantity is given in: " + strings.Join(inventoryUnitNames(), ", ") + ". Defaults to the unit the store stocks the item in.",
+                            Optional:    true,
+                            Validators:  []validator.String{oneOfValidator{values: inventoryUnitNames()}},
+                        },
+                    },
+                },
+            },
         },
     }
 }
 
+type allergensValidator struct{}
+
+func (v allergensValidator) Description(_ context.Context) string {
+    return "values must be one of: " + strings.Join(allergenNames, ", ")
+}
+
+func (v allergensValidator) MarkdownDescription(ctx context.Context) string { return v.Description(ctx) }
+
+func (v allergensValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() { return }
+    for _, element := range req.ConfigValue.Elements() {
+        allergen, ok := element.(types.String)
+        if !ok || allergen.IsUnknown() || allergen.IsNull() { continue }
+        known := false
+        for _, name := range allergenNames {
+            if allergen.ValueString() == name { known = true }
+        }
+        if !known {
+            resp.Diagnostics.AddAttributeError(req.Path, "Invalid Allergen",
+                fmt.Sprintf("%q is not a known allergen, expected one of: %s", allergen.ValueString(), strings.Join(allergenNames, ", ")))
+        }
+    }
+}
+
+func (r *menuItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config menuItemResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() { return }
+
+    if !config.Calories.IsNull() && !config.Nutrition.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("nutrition"), "Conflicting Calories", "calories cannot be set together with nutrition; use nutrition.calories.")
+    }
+    resp.Diagnostics.Append(validateMenuItemRecipe(ctx, config)...)
+
+    if config.Variants.IsNull() || config.Variants.IsUnknown() { return }
+    if !config.Size.IsNull() || !config.Price.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("variants"), "Conflicting Sizes", "size and price cannot be set together with variants.")
+    }
+
+    var variants []menuItemVariantModel
+    resp.Diagnostics.Append(config.Variants.ElementsAs(ctx, &variants, false)...)
+    if resp.Diagnostics.HasError() { return }
+
+    type sized struct {
+        size   string
+        volume float64
+        price  float64
+    }
+    var sizes []sized
+    seen := map[string]bool{}
+    for _, v := range variants {
+        if v.Size.IsUnknown() || v.VolumeOz.IsUnknown() || v.Price.IsUnknown() { return }
+        size := v.Size.ValueString()
+        if seen[size] {
+            resp.Diagnostics.AddAttributeError(path.Root("variants"), "Duplicate Size", fmt.Sprintf("Size %q is listed more than once.", size))
+            continue
+        }
+        seen[size] = true
+
+        if v.Price.ValueFloat64() < 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("variants"), "Invalid Price", fmt.Sprintf("Price of size %q cannot be negative.", size))
+        }
+        volume, ok := variantVolume(v)
+        if !ok {
+            resp.Diagnostics.AddAttributeError(path.Root("variants"), "Unknown Size Volume", fmt.Sprintf("Size %q is not a standard size, so volume_oz must be set.", size))
+            continue
+        }
+        sizes = append(sizes, sized{size: size, volume: volume, price: v.Price.ValueFloat64()})
+    }
+
+    sort.Slice(sizes, func(i, j int) bool { return sizes[i].volume < sizes[j].volume })
+    for i := 1; i < len(sizes); i++ {
+        smaller, larger := sizes[i-1], sizes[i]
+        if larger.volume > smaller.volume && larger.price <= smaller.price {
+            resp.Diagnostics.AddAttributeError(path.Root("variants"), "Invalid Variant Pricing",
+                fmt.Sprintf("Size %q (%.0f oz) costs %.2f, which is not more than the smaller size %q (%.0f oz) at %.2f.", larger.size, larger.volume, larger.price, smaller.size, smaller.volume, smaller.price))
+        }
+    }
+}
+
+// setMenuItemDetails adds calories, nutrition, allergens and recipe to body. Unset
+// values are sent as null so that updates clear them.
+func setMenuItemDetails(ctx context.Context, plan menuItemResourceModel, body map[string]interface{}) diag.Diagnostics {
+    var diags diag.Diagnostics
+    body["calories"], body["nutrition"], body["allergens"], body["recipe"] = nil, nil, nil, nil
+
+    if !plan.IsAvailable.IsNull() && !plan.IsAvailable.IsUnknown() { body["is_available"] = plan.IsAvailable.ValueBool() }
+    if !plan.IsSeasonal.IsNull() && !plan.IsSeasonal.IsUnknown() { body["is_seasonal"] = plan.IsSeasonal.ValueBool() }
+
+    if !plan.Calories.IsNull() { body["calories"] = plan.Calories.ValueInt64() }
+    if !plan.Nutrition.IsNull() {
+        var nutrition menuItemNutritionModel
+        diags.Append(plan.Nutrition.As(ctx, &nutrition, basetypes.ObjectAsOptions{})...)
+        facts := map[string]interface{}{}
+        if !nutrition.Calories.IsNull() { facts["calories"] = nutrition.Calories.ValueInt64() }
+        if !nutrition.FatG.IsNull() { facts["fat_g"] = nutrition.FatG.ValueFloat64() }
+        if !nutrition.SugarG.IsNull() { facts["sugar_g"] = nutrition.SugarG.ValueFloat64() }
+        if !nutrition.ProteinG.IsNull() { facts["protein_g"] = nutrition.ProteinG.ValueFloat64() }
+        if !nutrition.CaffeineMg.IsNull() { facts["caffeine_mg"] = nutrition.CaffeineMg.ValueInt64() }
+        if !nutrition.SodiumMg.IsNull() { facts["sodium_mg"] = nutrition.SodiumMg.ValueInt64() }
+        body["nutrition"] = facts
+        if !nutrition.Calories.IsNull() { body["calories"] = nutrition.Calories.ValueInt64() }
+    }
+    allergens, d := expandStrings(ctx, plan.Allergens)
+    diags.Append(d...)
+    body["allergens"] = allergens
+    if !plan.Recipe.IsNull() {
+        recipe, d := expandMenuItemRecipe(ctx, plan.Recipe)
+        diags.Append(d...)
+        body["recipe"] = recipe
+    }
+    return diags
+}
+
+// setMenuItemFlags resolves is_available and is_seasonal when they were left
+// to the API, falling back to available and not seasonal.
+func setMenuItemFlags(plan *menuItemResourceModel, result map[string]interface{}) {
+    if plan.IsAvailable.IsUnknown() {
+        plan.IsAvailable = types.BoolValue(true)
+        if v, ok := result["is_available"].(bool); ok { plan.IsAvailable = types.BoolValue(v) }
+    }
+    if plan.IsSeasonal.IsUnknown() {
+        plan.IsSeasonal = types.BoolValue(false)
+        if v, ok := result["is_seasonal"].(bool); ok { plan.IsSeasonal = types.BoolValue(v) }
+    }
+}
+
+// menuItemSizes returns the sizes configured for a menu item and whether they
+// are all known.
+func menuItemSizes(ctx context.Context, item menuItemResourceModel) (map[string]bool, bool) {
+    sizes := map[string]bool{}
+    if item.Variants.IsUnknown() || item.Size.IsUnknown() { return sizes, false }
+    if !item.Size.IsNull() { sizes[item.Size.ValueString()] = true }
+    if item.Variants.IsNull() { return sizes, true }
+    var variants []menuItemVariantModel
+    if diags := item.Variants.ElementsAs(ctx, &variants, false); diags.HasError() { return sizes, false }
+    for _, v := range variants {
+        if v.Size.IsUnknown() { return sizes, false }
+        sizes[v.Size.ValueString()] = true
+    }
+    return sizes, true
+}
+
+func validateMenuItemRecipe(ctx context.Context, config menuItemResourceModel) diag.Diagnostics {
+    var diags diag.Diagnostics
+    if config.Recipe.IsNull() || config.Recipe.IsUnknown() { return diags }
+    var ingredients []menuItemIngredientModel
+    diags.Append(config.Recipe.ElementsAs(ctx, &ingredients, false)...)
+    if diags.HasError() { return diags }
+
+    sizes, sizesKnown := menuItemSizes(ctx, config)
+    seen := map[string]bool{}
+    for i, ingredient := range ingredients {
+        at := path.Root("recipe").AtListIndex(i)
+        if !ingredient.Quantity.IsUnknown() && ingredient.Quantity.ValueFloat64() <= 0 {
+            diags.AddAttributeError(at.AtName("quantity"), "Invalid Quantity", "Ingredient quantity must be greater than zero.")
+        }
+        if ingredient.Size.IsUnknown() || ingredient.ItemSKU.IsUnknown() { continue }
+        size := ingredient.Size.ValueString()
+        if !ingredient.Size.IsNull() && sizesKnown && !sizes[size] {
+            diags.AddAttributeError(at.AtName("size"), "Unknown Recipe Size", fmt.Sprintf("Size %q is not a size of this menu item.", size))
+        }
+        key := size + "/" + ingredient.ItemSKU.ValueString()
+        if seen[key] {
+            diags.AddAttributeError(at, "Duplicate Ingredient", fmt.Sprintf("SKU %q is listed more than once for the same size.", ingredient.ItemSKU.ValueString()))
+        }
+        seen[key] = true
+    }
+    return diags
+}
+
+// ModifyPlan warns about recipe SKUs that are not in the product catalog. It
+// only warns, since a product created in the same apply is not in the catalog
+// yet at plan time.
+func (r *menuItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() || r.client == nil { return }
+    var plan, state menuItemResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if !req.State.Raw.IsNull() { resp.Diagnostics.Append(req.State.Get(ctx, &state)...) }
+    if resp.Diagnostics.HasError() || plan.Recipe.IsNull() || plan.Recipe.IsUnknown() { return }
+    if !req.State.Raw.IsNull() && plan.Recipe.Equal(state.Recipe) { return }
+
+    var ingredients []menuItemIngredientModel
+    resp.Diagnostics.Append(plan.Recipe.ElementsAs(ctx, &ingredients, false)...)
+    if resp.Diagnostics.HasError() { return }
+
+    checked := map[string]bool{}
+    for i, ingredient := range ingredients {
+        sku := ingredient.ItemSKU.ValueString()
+        if ingredient.ItemSKU.IsUnknown() || checked[sku] { continue }
+        checked[sku] = true
+        product, err := findProduct(r.client, sku)
+        if err != nil {
+            resp.Diagnostics.AddWarning("Unable to Check Recipe SKUs", fmt.Sprintf("Unable to look up product SKU %q: %s", sku, err))
+            return
+        }
+        if product == nil {
+            resp.Diagnostics.AddAttributeWarning(path.Root("recipe").AtListIndex(i).AtName("item_sku"), "Unknown SKU",
+                fmt.Sprintf("SKU %q is not in the product catalog. Unless a starbucks_product for it is created in this apply, stores cannot stock it.", sku))
+        }
+    }
+}
+
+func expandMenuItemRecipe(ctx context.Context, value types.List) ([]interface{}, diag.Diagnostics) {
+    var ingredients []menuItemIngredientModel
+    diags := value.ElementsAs(ctx, &ingredients, false)
+    result := make([]interface{}, 0, len(ingredients))
+    for _, ingredient := range ingredients {
+        entry := map[string]interface{}{"item_sku": ingredient.ItemSKU.ValueString(), "quantity": ingredient.Quantity.ValueFloat64()}
+        if !ingredient.Size.IsNull() { entry["size"] = ingredient.Size.ValueString() }
+        if !ingredient.Unit.IsNull() { entry["unit"] = ingredient.Unit.ValueString() }
+        result = append(result, entry)
+    }
+    return result, diags
+}
+
+func flattenMenuItemRecipe(ctx context.Context, raw []interface{}) (types.List, diag.Diagnostics) {
+    ingredients := make([]menuItemIngredientModel, 0, len(raw))
+    for _, e := range raw {
+        entry, ok := e.(map[string]interface{})
+        if !ok { continue }
+        ingredient := menuItemIngredientModel{Size: types.StringNull(), ItemSKU: types.StringNull(), Quantity: types.Float64Null(), Unit: types.StringNull()}
+        if v, ok := entry["size"].(string); ok && v != "" { ingredient.Size = types.StringValue(v) }
+        if v, ok := entry["item_sku"].(string); ok { ingredient.ItemSKU = types.StringValue(v) }
+        if v, ok := entry["quantity"].(float64); ok { ingredient.Quantity = types.Float64Value(v) }
+        if v, ok := entry["unit"].(string); ok && v != "" { ingredient.Unit = types.StringValue(v) }
+        ingredients = append(ingredients, ingredient)
+    }
+    return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: menuItemIngredientAttrTypes}, ingredients)
+}
+
+// flattenMenuItemNutrition converts the API nutrition facts into the
+// nutrition object. When nutrition is already in state only the facts set
+// there are kept, so facts the API fills in do not show a diff. Without prior
+// state, e.g. after import, every fact the API reports is read.
+func flattenMenuItemNutrition(prior types.Object, raw map[string]interface{}) (types.Object, diag.Diagnostics) {
+    var configured map[string]attr.Value
+    if !prior.IsNull() && !prior.IsUnknown() { configured = prior.Attributes() }
+    values := map[string]attr.Value{
+        "calories":    types.Int64Null(),
+        "fat_g":       types.Float64Null(),
+        "sugar_g":     types.Float64Null(),
+        "protein_g":   types.Float64Null(),
+        "caffeine_mg": types.Int64Null(),
+        "sodium_mg":   types.Int64Null(),
+    }
+    found := false
+    for key, t := range menuItemNutritionAttrTypes {
+        v, ok := raw[key].(float64)
+        if !ok { continue }
+        if configured != nil && (configured[key] == nil || configured[key].IsNull()) { continue }
+        if t == types.Int64Type {
+            values[key] = types.Int64Value(int64(v))
+        } else {
+            values[key] = types.Float64Value(v)
+        }
+        found = true
+    }
+    if !found && configured == nil { return types.ObjectNull(menuItemNutritionAttrTypes), nil }
+    return types.ObjectValue(menuItemNutritionAttrTypes, values)
+}
+
+func variantVolume(v menuItemVariantModel) (float64, bool) {
+    if !v.VolumeOz.IsNull() { return v.VolumeOz.ValueFloat64(), true }
+    volume, ok := drinkSizeVolumes[v.Size.ValueString()]
+    return volume, ok
+}
+
+func expandMenuItemVariants(ctx context.Context, value types.Set) ([]interface{}, diag.Diagnostics) {
+    var variants []menuItemVariantModel
+    diags := value.ElementsAs(ctx, &variants, false)
+    result := make([]interface{}, 0, len(variants))
+    for _, v := range variants {
+        variant := map[string]interface{}{"size": v.Size.ValueString(), "price": v.Price.ValueFloat64()}
+        if volume, ok := variantVolume(v); ok { variant["volume_oz"] = volume }
+        if !v.Calories.IsNull() { variant["calories"] = v.Calories.ValueInt64() }
+        if !v.CaffeineMg.IsNull() { variant["caffeine_mg"] = v.CaffeineMg.ValueInt64() }
+        result = append(result, variant)
+    }
+    return result, diags
+}
+
+// flattenMenuItemVariants converts the API variants into the variants set.
+// volume_oz is only kept when it was configured or the size is non-standard,
+// so standard sizes do not show a diff for the volume the API fills in.
+func flattenMenuItemVariants(ctx context.Context, prior types.Set, raw []interface{}) (types.Set, diag.Diagnostics) {
+    var priorVariants []menuItemVariantModel
+    if !prior.IsNull() && !prior.IsUnknown() {
+        if diags := prior.ElementsAs(ctx, &priorVariants, false); diags.HasError() { return prior, diags }
+    }
+    configuredVolume := map[string]bool{}
+    for _, v := range priorVariants { configuredVolume[v.Size.ValueString()] = !v.VolumeOz.IsNull() }
+
+    variants := make([]menuItemVariantModel, 0, len(raw))
+    for _, e := range raw {
+        entry, ok := e.(map[string]interface{})
+        if !ok { continue }
+        size, _ := entry["size"].(string)
+        variant := menuItemVariantModel{
+            Size:       types.StringValue(size),
+            VolumeOz:   types.Float64Null(),
+            Price:      types.Float64Null(),
+            Calories:   types.Int64Null(),
+            CaffeineMg: types.Int64Null(),
+        }
+        _, standard := drinkSizeVolumes[size]
+        if v, ok := entry["volume_oz"].(float64); ok && (configuredVolume[size] || !standard) { variant.VolumeOz = types.Float64Value(v) }
+        if v, ok := entry["price"].(float64); ok { variant.Price = types.Float64Value(v) }
+        if v, ok := entry["calories"].(float64); ok { variant.Calories = types.Int64Value(int64(v)) }
+        if v, ok := entry["caffeine_mg"].(float64); ok { variant.CaffeineMg = types.Int64Value(int64(v)) }
+        variants = append(variants, variant)
+    }
+    return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: menuItemVariantAttrTypes}, variants)
+}
+
 func (r *menuItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
     if req.ProviderData == nil { return }
     client, ok := req.ProviderData.(*StarbucksClient)
@@ -70,6 +506,14 @@ func (r *menuItemResource) Create(ctx context.Context, req resource.CreateReques
         "size": func() interface{} { if plan.Size.IsNull() { return nil }; return plan.Size.ValueString() }(),
         "price": func() interface{} { if plan.Price.IsNull() { return nil }; return plan.Price.ValueFloat64() }(),
     }
+    if !plan.Variants.IsNull() {
+        variants, diags := expandMenuItemVariants(ctx, plan.Variants)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() { return }
+        requestBody["variants"] = variants
+    }
+    resp.Diagnostics.Append(setMenuItemDetails(ctx, plan, requestBody)...)
+    if resp.Diagnostics.HasError() { return }
 
     respBody, err := r.client.DoRequest("POST", "/menu_items", requestBody)
     if err != nil {
@@ -83,6 +527,7 @@ func (r *menuItemResource) Create(ctx context.Context, req resource.CreateReques
         return
     }
     if id, ok := result["id"].(string); ok { plan.ID = types.StringValue(id) }
+    setMenuItemFlags(&plan, result)
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -102,6 +547,35 @@ func (r *menuItemResource) Read(ctx context.Context, req resource.ReadRequest, r
         return
     }
     if v, ok := result["name"].(string); ok { state.Name = types.StringValue(v) }
+    if v, ok := result["is_available"].(bool); ok { state.IsAvailable = types.BoolValue(v) }
+    if v, ok := result["is_seasonal"].(bool); ok { state.IsSeasonal = types.BoolValue(v) }
+    if v, ok := result["variants"].([]interface{}); ok && (len(v) > 0 || !state.Variants.IsNull()) {
+        variants, diags := flattenMenuItemVariants(ctx, state.Variants, v)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() { return }
+        state.Variants = variants
+    }
+    // Deprecated calories is only read when it is in state or the API has no
+    // nutrition facts to read into nutrition.calories instead.
+    nutritionFacts, hasNutrition := result["nutrition"].(map[string]interface{})
+    if v, ok := result["calories"].(float64); ok && (!state.Calories.IsNull() || state.Nutrition.IsNull() && !hasNutrition) { state.Calories = types.Int64Value(int64(v)) }
+    if hasNutrition && (!state.Nutrition.IsNull() || state.Calories.IsNull()) {
+        nutrition, diags := flattenMenuItemNutrition(state.Nutrition, nutritionFacts)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() { return }
+        state.Nutrition = nutrition
+    }
+    if allergens, ok := flattenStrings(result["allergens"], state.Allergens.IsNull()); ok {
+        value, diags := types.SetValueFrom(ctx, types.StringType, allergens)
+        resp.Diagnostics.Append(diags...)
+        state.Allergens = value
+    }
+    if v, ok := result["recipe"].([]interface{}); ok && (len(v) > 0 || !state.Recipe.IsNull()) {
+        recipe, diags := flattenMenuItemRecipe(ctx, v)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() { return }
+        state.Recipe = recipe
+    }
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -110,9 +584,20 @@ func (r *menuItemResource) Update(ctx context.Context, req resource.UpdateReques
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() { return }
 
-    body := map[string]interface{}{"name": plan.Name.ValueString()}
-    _, err := r.client.DoRequest("PUT", "/menu_items/"+plan.ID.ValueString(), body)
+    body := map[string]interface{}{"name": plan.Name.ValueString(), "variants": nil}
+    if !plan.Variants.IsNull() {
+        variants, diags := expandMenuItemVariants(ctx, plan.Variants)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() { return }
+        body["variants"] = variants
+    }
+    resp.Diagnostics.Append(setMenuItemDetails(ctx, plan, body)...)
+    if resp.Diagnostics.HasError() { return }
+    respBody, err := r.client.DoRequest("PUT", "/menu_items/"+plan.ID.ValueString(), body)
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update menu item: %s", err)); return }
+    var result map[string]interface{}
+    _ = json.Unmarshal(respBody, &result)
+    setMenuItemFlags(&plan, result)
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
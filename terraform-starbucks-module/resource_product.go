@@ -0,0 +1,263 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/url"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &productResource{}
+var _ resource.ResourceWithImportState = &productResource{}
+var _ resource.ResourceWithValidateConfig = &productResource{}
+
+type productResource struct {
+    client *StarbucksClient
+}
+
+type productResourceModel struct {
+    ID            types.String  `tfsdk:"id"`
+    SKU           types.String  `tfsdk:"sku"`
+    Name          types.String  `tfsdk:"name"`
+    Category      types.String  `tfsdk:"category"`
+    Unit          types.String  `tfsdk:"unit"`
+    UnitCost      types.Float64 `tfsdk:"unit_cost"`
+    SupplierID    types.String  `tfsdk:"supplier_id"`
+    ShelfLifeDays types.Int64   `tfsdk:"shelf_life_days"`
+}
+
+func NewProductResource() resource.Resource {
+    return &productResource{}
+}
+
+func (r *productResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+func (r *productResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    units := inventoryUnitNames()
+    resp.Schema = schema.Schema{
+        Description: "Manages a product in the SKU catalog. Inventory can only be held for SKUs in the catalog.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the product",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "sku": schema.StringAttribute{
+                Description: "Stock keeping unit, e.g. BEAN-PIKE-1LB",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+                Validators: []validator.String{skuValidator{}},
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the product",
+                Required:    true,
+            },
+            "category": schema.StringAttribute{
+                Description: "Product category, e.g. beans, milk or packaging",
+                Optional:    true,
+            },
+            "unit": schema.StringAttribute{
+                Description: "Unit the product is stocked in: " + strings.Join(units, ", "),
+                Required:    true,
+                Validators:  []validator.String{oneOfValidator{values: units}},
+            },
+            "unit_cost": schema.Float64Attribute{
+                Description: "Cost per unit (USD)",
+                Optional:    true,
+            },
+            "supplier_id": schema.StringAttribute{
+                Description: "ID of the supplier the product is bought from",
+                Optional:    true,
+            },
+            "shelf_life_days": schema.Int64Attribute{
+                Description: "Days the product keeps once received",
+                Optional:    true,
+            },
+        },
+    }
+}
+
+func (r *productResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *productResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config productResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.UnitCost.IsNull() && !config.UnitCost.IsUnknown() && config.UnitCost.ValueFloat64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("unit_cost"), "Invalid Unit Cost", "unit_cost cannot be negative.")
+    }
+    if !config.ShelfLifeDays.IsNull() && !config.ShelfLifeDays.IsUnknown() && config.ShelfLifeDays.ValueInt64() <= 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("shelf_life_days"), "Invalid Shelf Life", "shelf_life_days must be greater than zero.")
+    }
+}
+
+// findProduct looks up a SKU in the product catalog. It returns nil when the
+// SKU is not in the catalog.
+func findProduct(client *StarbucksClient, sku string) (map[string]interface{}, error) {
+    products, err := client.ListAll("/products?sku=" + url.QueryEscape(sku))
+    if err != nil {
+        return nil, err
+    }
+    for _, product := range products {
+        if product["sku"] == sku {
+            return product, nil
+        }
+    }
+    return nil, nil
+}
+
+func (m *productResourceModel) requestBody() map[string]interface{} {
+    body := map[string]interface{}{
+        "sku":             m.SKU.ValueString(),
+        "name":            m.Name.ValueString(),
+        "unit":            m.Unit.ValueString(),
+        "category":        nil,
+        "unit_cost":       nil,
+        "supplier_id":     nil,
+        "shelf_life_days": nil,
+    }
+    if !m.Category.IsNull() {
+        body["category"] = m.Category.ValueString()
+    }
+    if !m.UnitCost.IsNull() {
+        body["unit_cost"] = m.UnitCost.ValueFloat64()
+    }
+    if !m.SupplierID.IsNull() {
+        body["supplier_id"] = m.SupplierID.ValueString()
+    }
+    if !m.ShelfLifeDays.IsNull() {
+        body["shelf_life_days"] = m.ShelfLifeDays.ValueInt64()
+    }
+    return body
+}
+
+func (r *productResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan productResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", "/products", plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create product: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *productResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state productResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", "/products/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["sku"].(string); ok {
+        state.SKU = types.StringValue(val)
+    }
+    if val, ok := result["name"].(string); ok {
+        state.Name = types.StringValue(val)
+    }
+    if val, ok := result["category"].(string); ok {
+        state.Category = types.StringValue(val)
+    }
+    if val, ok := result["unit"].(string); ok {
+        state.Unit = types.StringValue(val)
+    }
+    if val, ok := result["unit_cost"].(float64); ok {
+        state.UnitCost = types.Float64Value(val)
+    }
+    if val, ok := result["supplier_id"].(string); ok {
+        state.SupplierID = types.StringValue(val)
+    }
+    if val, ok := result["shelf_life_days"].(float64); ok {
+        state.ShelfLifeDays = types.Int64Value(int64(val))
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *productResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan productResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", "/products/"+plan.ID.ValueString(), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update product: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *productResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state productResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", "/products/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete product: %s", err))
+        return
+    }
+}
+
+func (r *productResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
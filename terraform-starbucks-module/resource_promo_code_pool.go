@@ -0,0 +1,328 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "math"
+    "regexp"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &promoCodePoolResource{}
+var _ resource.ResourceWithImportState = &promoCodePoolResource{}
+var _ resource.ResourceWithValidateConfig = &promoCodePoolResource{}
+var _ resource.ResourceWithModifyPlan = &promoCodePoolResource{}
+
+// defaultPromoCodeAlphabet leaves out characters that are easily misread at
+// the register: 0/O, 1/I and L.
+const defaultPromoCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// maxPromoCodeLength is the longest code, prefix included, the register
+// accepts. It matches promoCodePattern.
+const maxPromoCodeLength = 20
+
+var promoCodeCharacters = regexp.MustCompile(`^[A-Z0-9]*$`)
+
+type promoCodePoolResource struct {
+    client *StarbucksClient
+}
+
+type promoCodePoolResourceModel struct {
+    ID          types.String `tfsdk:"id"`
+    PromotionID types.String `tfsdk:"promotion_id"`
+    Prefix      types.String `tfsdk:"prefix"`
+    CodeLength  types.Int64  `tfsdk:"code_length"`
+    Alphabet    types.String `tfsdk:"alphabet"`
+    CodeCount   types.Int64  `tfsdk:"code_count"`
+    Codes       types.List   `tfsdk:"codes"`
+}
+
+func NewPromoCodePoolResource() resource.Resource {
+    return &promoCodePoolResource{}
+}
+
+func (r *promoCodePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_promo_code_pool"
+}
+
+func (r *promoCodePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a pool of generated single-use codes for a promotion. Increasing code_count adds codes to the pool; codes already issued are never regenerated.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the pool",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "promotion_id": schema.StringAttribute{
+                Description: "ID of the promotion the codes redeem",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "prefix": schema.StringAttribute{
+                Description: "Upper-case letters and digits every code starts with, e.g. FALL",
+                Optional:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "code_length": schema.Int64Attribute{
+                Description: "Number of generated characters after the prefix. Defaults to 8.",
+                Optional:    true,
+                Computed:    true,
+                Default:     int64default.StaticInt64(8),
+                PlanModifiers: []planmodifier.Int64{
+                    int64planmodifier.RequiresReplace(),
+                },
+            },
+            "alphabet": schema.StringAttribute{
+                Description: "Characters codes are generated from. Defaults to upper-case letters and digits without 0, O, 1, I and L.",
+                Optional:    true,
+                Computed:    true,
+                Default:     stringdefault.StaticString(defaultPromoCodeAlphabet),
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "code_count": schema.Int64Attribute{
+                Description: "Number of codes in the pool. Can be increased to add codes but not decreased.",
+                Required:    true,
+            },
+            "codes": schema.ListAttribute{
+                Description: "Generated codes, oldest first",
+                ElementType: types.StringType,
+                Computed:    true,
+                Sensitive:   true,
+            },
+        },
+    }
+}
+
+func (r *promoCodePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *promoCodePoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    prefix, length, alphabet := config.Prefix.ValueString(), config.CodeLength.ValueInt64(), config.Alphabet.ValueString()
+    if config.CodeLength.IsNull() {
+        length = 8
+    }
+    if config.Alphabet.IsNull() {
+        alphabet = defaultPromoCodeAlphabet
+    }
+
+    if !config.CodeCount.IsUnknown() && config.CodeCount.ValueInt64() <= 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("code_count"), "Invalid Code Count", "code_count must be greater than zero.")
+    }
+    if !config.Prefix.IsUnknown() && !promoCodeCharacters.MatchString(prefix) {
+        resp.Diagnostics.AddAttributeError(path.Root("prefix"), "Invalid Prefix", "prefix may only contain upper-case letters and digits.")
+    }
+    if !config.CodeLength.IsUnknown() && length < 4 {
+        resp.Diagnostics.AddAttributeError(path.Root("code_length"), "Invalid Code Length", "code_length must be at least 4.")
+    }
+    if !config.Prefix.IsUnknown() && !config.CodeLength.IsUnknown() && int64(len(prefix))+length > maxPromoCodeLength {
+        resp.Diagnostics.AddAttributeError(path.Root("code_length"), "Invalid Code Length",
+            fmt.Sprintf("Codes are %d characters long with the prefix; the register accepts at most %d.", int64(len(prefix))+length, maxPromoCodeLength))
+    }
+
+    if config.Alphabet.IsUnknown() {
+        return
+    }
+    if !promoCodeCharacters.MatchString(alphabet) {
+        resp.Diagnostics.AddAttributeError(path.Root("alphabet"), "Invalid Alphabet", "alphabet may only contain upper-case letters and digits.")
+        return
+    }
+    distinct := map[rune]bool{}
+    for _, c := range alphabet {
+        if distinct[c] {
+            resp.Diagnostics.AddAttributeError(path.Root("alphabet"), "Invalid Alphabet", fmt.Sprintf("alphabet contains %q more than once.", c))
+            return
+        }
+        distinct[c] = true
+    }
+    if len(distinct) < 2 {
+        resp.Diagnostics.AddAttributeError(path.Root("alphabet"), "Invalid Alphabet", "alphabet needs at least two characters.")
+        return
+    }
+
+    if config.CodeCount.IsUnknown() || config.CodeLength.IsUnknown() {
+        return
+    }
+    if capacity := math.Pow(float64(len(distinct)), float64(length)); float64(config.CodeCount.ValueInt64()) > capacity {
+        resp.Diagnostics.AddAttributeError(path.Root("code_count"), "Invalid Code Count",
+            fmt.Sprintf("Only %.0f distinct codes of length %d can be made from the alphabet. Increase code_length or use a larger alphabet.", capacity, length))
+    }
+}
+
+// ModifyPlan rejects shrinking a pool, since its codes may already have been
+// handed out.
+func (r *promoCodePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var state, plan promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() || plan.CodeCount.IsUnknown() {
+        return
+    }
+
+    if plan.CodeCount.ValueInt64() < state.CodeCount.ValueInt64() {
+        resp.Diagnostics.AddAttributeError(path.Root("code_count"), "Promo Code Pool Cannot Shrink",
+            fmt.Sprintf("The pool already holds %d codes that may have been handed out. Codes cannot be removed; replace the pool to start over.", state.CodeCount.ValueInt64()))
+    }
+}
+
+func (r *promoCodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    pool := map[string]interface{}{
+        "prefix":      plan.Prefix.ValueString(),
+        "code_length": plan.CodeLength.ValueInt64(),
+        "alphabet":    plan.Alphabet.ValueString(),
+    }
+    result, err := r.client.CreatePromoCodePool(plan.PromotionID.ValueString(), pool)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create promo code pool: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    codes, err := r.client.GeneratePromoCodes(plan.PromotionID.ValueString(), plan.ID.ValueString(), plan.CodeCount.ValueInt64())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate promo codes: %s. The pool holds %d of %d codes.", err, len(codes), plan.CodeCount.ValueInt64()))
+    }
+
+    // Save the pool even when generation failed so that it is not orphaned.
+    if codes == nil {
+        codes = []string{}
+    }
+    value, diags := types.ListValueFrom(ctx, types.StringType, codes)
+    resp.Diagnostics.Append(diags...)
+    plan.Codes = value
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *promoCodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.GetPromoCodePool(state.PromotionID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read promo code pool: %s", err))
+        return
+    }
+    if val, ok := result["prefix"].(string); ok && (val != "" || !state.Prefix.IsNull()) {
+        state.Prefix = types.StringValue(val)
+    }
+    if val, ok := result["code_length"].(float64); ok {
+        state.CodeLength = types.Int64Value(int64(val))
+    }
+    if val, ok := result["alphabet"].(string); ok {
+        state.Alphabet = types.StringValue(val)
+    }
+
+    codes, err := r.client.ListPromoCodes(state.PromotionID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list promo codes: %s", err))
+        return
+    }
+    // code_count reflects the codes actually in the pool, so a shortfall from
+    // a failed generation shows up as a diff and is retried on the next apply.
+    state.CodeCount = types.Int64Value(int64(len(codes)))
+    value, diags := types.ListValueFrom(ctx, types.StringType, codes)
+    resp.Diagnostics.Append(diags...)
+    state.Codes = value
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *promoCodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan, state promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var codes []string
+    resp.Diagnostics.Append(state.Codes.ElementsAs(ctx, &codes, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if added := plan.CodeCount.ValueInt64() - int64(len(codes)); added > 0 {
+        generated, err := r.client.GeneratePromoCodes(plan.PromotionID.ValueString(), plan.ID.ValueString(), added)
+        codes = append(codes, generated...)
+        if err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate promo codes: %s. The pool holds %d of %d codes; the rest are generated on the next apply.", err, len(codes), plan.CodeCount.ValueInt64()))
+        }
+    }
+
+    value, diags := types.ListValueFrom(ctx, types.StringType, codes)
+    resp.Diagnostics.Append(diags...)
+    plan.Codes = value
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *promoCodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state promoCodePoolResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if err := r.client.DeletePromoCodePool(state.PromotionID.ValueString(), state.ID.ValueString()); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete promo code pool: %s", err))
+        return
+    }
+}
+
+func (r *promoCodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    parts := strings.Split(req.ID, "/")
+    if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+        resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form promotion_id/pool_id, got: %q", req.ID))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("promotion_id"), parts[0])...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
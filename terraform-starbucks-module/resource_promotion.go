@@ -4,21 +4,57 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "regexp"
+    "sort"
+    "strings"
+    "time"
 
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var _ resource.ResourceWithValidateConfig = &promotionResource{}
+var _ resource.ResourceWithModifyPlan = &promotionResource{}
+
+const (
+    discountTypePercentage  = "percentage"
+    discountTypeFixedAmount = "fixed_amount"
+    discountTypeBOGO        = "bogo"
+    discountTypeFreeItem    = "free_item"
+)
+
+var promotionDiscountTypes = []string{discountTypePercentage, discountTypeFixedAmount, discountTypeBOGO, discountTypeFreeItem}
+
+// promoCodePattern matches codes customers can type at the register, such as
+// FALL2024.
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9]{4,20}$`)
+
 type promotionResource struct { client *StarbucksClient }
 
 type promotionResourceModel struct {
-    ID          types.String `tfsdk:"id"`
-    Name        types.String `tfsdk:"name"`
-    Description types.String `tfsdk:"description"`
-    StartDate   types.String `tfsdk:"start_date"`
-    EndDate     types.String `tfsdk:"end_date"`
-    Active      types.Bool   `tfsdk:"active"`
+    ID                    types.String  `tfsdk:"id"`
+    Name                  types.String  `tfsdk:"name"`
+    Description           types.String  `tfsdk:"description"`
+    StartDate             types.String  `tfsdk:"start_date"`
+    EndDate               types.String  `tfsdk:"end_date"`
+    Active                types.Bool    `tfsdk:"active"`
+    DiscountType          types.String  `tfsdk:"discount_type"`
+    DiscountValue         types.Float64 `tfsdk:"discount_value"`
+    FreeMenuItemID        types.String  `tfsdk:"free_menu_item_id"`
+    PromoCode             types.String  `tfsdk:"promo_code"`
+    UsageLimit            types.Int64   `tfsdk:"usage_limit"`
+    UsageLimitPerCustomer types.Int64   `tfsdk:"usage_limit_per_customer"`
+    RedemptionCount       types.Int64   `tfsdk:"redemption_count"`
+    StoreIDs              types.Set     `tfsdk:"store_ids"`
+    Regions               types.Set     `tfsdk:"regions"`
+    MenuItemIDs           types.Set     `tfsdk:"menu_item_ids"`
 }
 
 func NewPromotionResource() resource.Resource { return &promotionResource{} }
@@ -29,14 +65,28 @@ func (r *promotionResource) Metadata(_ context.Context, req resource.MetadataReq
 
 func (r *promotionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
     resp.Schema = schema.Schema{
-        Description: "Manages promotional campaigns.",
+        Description: "Manages promotional campaigns. A promotion without store_ids, regions or menu_item_ids applies to every store and menu item.",
         Attributes: map[string]schema.Attribute{
-            "id": schema.StringAttribute{Computed: true},
+            "id": schema.StringAttribute{Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
             "name": schema.StringAttribute{Required: true},
             "description": schema.StringAttribute{Optional: true},
-            "start_date": schema.StringAttribute{Optional: true},
-            "end_date": schema.StringAttribute{Optional: true},
-            "active": schema.BoolAttribute{Optional: true, Computed: true},
+            "start_date": schema.StringAttribute{Description: "When the promotion starts, as an RFC 3339 timestamp with a timezone offset, e.g. 2024-09-01T00:00:00-07:00. Unset starts immediately.", Optional: true, Validators: []validator.String{timestampValidator{}}},
+            "end_date": schema.StringAttribute{Description: "When the promotion ends, as an RFC 3339 timestamp with a timezone offset. Unset runs until removed.", Optional: true, Validators: []validator.String{timestampValidator{}}},
+            "active": schema.BoolAttribute{Description: "Whether the promotion is running. Unless set, the API decides from start_date, end_date and usage limits; setting it overrides the schedule.", Optional: true, Computed: true},
+            "discount_type": schema.StringAttribute{
+                Description: "Kind of discount: " + strings.Join(promotionDiscountTypes, ", ") + ". Promotions created before discount types were supported may leave it unset.",
+                Optional:    true,
+                Validators:  []validator.String{oneOfValidator{values: promotionDiscountTypes}},
+            },
+            "discount_value": schema.Float64Attribute{Description: "Percentage off (0-100) for percentage discounts, or amount off (USD) for fixed_amount discounts", Optional: true},
+            "free_menu_item_id": schema.StringAttribute{Description: "Menu item given away by free_item discounts", Optional: true},
+            "promo_code": schema.StringAttribute{Description: "Code customers enter to redeem the promotion: 4-20 upper-case letters and digits. Without a code the discount applies automatically.", Optional: true},
+            "usage_limit": schema.Int64Attribute{Description: "Total redemptions allowed across all customers", Optional: true},
+            "usage_limit_per_customer": schema.Int64Attribute{Description: "Redemptions allowed per customer", Optional: true},
+            "redemption_count": schema.Int64Attribute{Description: "Times the promotion has been redeemed", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+            "store_ids": schema.SetAttribute{Description: "Stores the promotion is limited to", ElementType: types.StringType, Optional: true},
+            "regions": schema.SetAttribute{Description: "Regions the promotion is limited to", ElementType: types.StringType, Optional: true},
+            "menu_item_ids": schema.SetAttribute{Description: "Menu items the discount is limited to", ElementType: types.StringType, Optional: true},
         },
     }
 }
@@ -48,16 +98,254 @@ func (r *promotionResource) Configure(_ context.Context, req resource.ConfigureR
     r.client = client
 }
 
+func (r *promotionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config promotionResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() { return }
+
+    if !config.DiscountType.IsNull() && !config.DiscountType.IsUnknown() && !config.DiscountValue.IsUnknown() {
+        value := config.DiscountValue.ValueFloat64()
+        switch config.DiscountType.ValueString() {
+        case discountTypePercentage:
+            if config.DiscountValue.IsNull() || value <= 0 || value > 100 {
+                resp.Diagnostics.AddAttributeError(path.Root("discount_value"), "Invalid Discount Value", "Percentage discounts need a discount_value greater than 0 and at most 100.")
+            }
+        case discountTypeFixedAmount:
+            if config.DiscountValue.IsNull() || value <= 0 {
+                resp.Diagnostics.AddAttributeError(path.Root("discount_value"), "Invalid Discount Value", "Fixed amount discounts need a discount_value greater than 0.")
+            }
+        default:
+            if !config.DiscountValue.IsNull() {
+                resp.Diagnostics.AddAttributeError(path.Root("discount_value"), "Invalid Discount Value", fmt.Sprintf("%s discounts do not take a discount_value.", config.DiscountType.ValueString()))
+            }
+        }
+    }
+    if !config.DiscountType.IsNull() && !config.DiscountType.IsUnknown() && !config.FreeMenuItemID.IsUnknown() && (config.DiscountType.ValueString() == discountTypeFreeItem) == config.FreeMenuItemID.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("free_menu_item_id"), "Invalid Free Menu Item", "free_menu_item_id must be set for free_item discounts and only for them.")
+    }
+    if !config.PromoCode.IsNull() && !config.PromoCode.IsUnknown() && !promoCodePattern.MatchString(config.PromoCode.ValueString()) {
+        resp.Diagnostics.AddAttributeError(path.Root("promo_code"), "Invalid Promo Code", fmt.Sprintf("%q must be 4-20 upper-case letters and digits.", config.PromoCode.ValueString()))
+    }
+    if !config.UsageLimit.IsNull() && !config.UsageLimit.IsUnknown() && config.UsageLimit.ValueInt64() <= 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("usage_limit"), "Invalid Usage Limit", "usage_limit must be greater than zero.")
+    }
+    if !config.UsageLimitPerCustomer.IsNull() && !config.UsageLimitPerCustomer.IsUnknown() {
+        perCustomer := config.UsageLimitPerCustomer.ValueInt64()
+        if perCustomer <= 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("usage_limit_per_customer"), "Invalid Usage Limit", "usage_limit_per_customer must be greater than zero.")
+        } else if !config.UsageLimit.IsNull() && !config.UsageLimit.IsUnknown() && perCustomer > config.UsageLimit.ValueInt64() {
+            resp.Diagnostics.AddAttributeError(path.Root("usage_limit_per_customer"), "Invalid Usage Limit", "usage_limit_per_customer cannot exceed usage_limit.")
+        }
+    }
+    start, startOK := parsePromotionTime(config.StartDate)
+    end, endOK := parsePromotionTime(config.EndDate)
+    if startOK && endOK && !end.After(start) {
+        resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid Promotion Dates", "end_date must be after start_date.")
+    }
+}
+
+// parsePromotionTime parses a promotion timestamp. It reports false for null,
+// unknown and malformed values.
+func parsePromotionTime(value types.String) (time.Time, bool) {
+    if value.IsNull() || value.IsUnknown() { return time.Time{}, false }
+    t, err := time.Parse(time.RFC3339, value.ValueString())
+    return t, err == nil
+}
+
+// promotionWindow is the period a promotion runs for. A zero start or end
+// leaves that side of the window open.
+type promotionWindow struct { start, end time.Time }
+
+func (w promotionWindow) contains(t time.Time) bool {
+    return (w.start.IsZero() || !t.Before(w.start)) && (w.end.IsZero() || t.Before(w.end))
+}
+
+// settled reports whether contains gives the same answer from t onwards,
+// i.e. neither end of the window is still to come.
+func (w promotionWindow) settled(t time.Time) bool {
+    return !w.start.After(t) && !w.end.After(t)
+}
+
+func (w promotionWindow) overlaps(o promotionWindow) bool {
+    return (w.end.IsZero() || o.start.IsZero() || o.start.Before(w.end)) && (o.end.IsZero() || w.start.IsZero() || w.start.Before(o.end))
+}
+
+func (m *promotionResourceModel) window() promotionWindow {
+    start, _ := parsePromotionTime(m.StartDate)
+    end, _ := parsePromotionTime(m.EndDate)
+    return promotionWindow{start: start, end: end}
+}
+
+func promotionWindowFromAPI(promotion map[string]interface{}) promotionWindow {
+    var w promotionWindow
+    if v, ok := promotion["start_date"].(string); ok { w.start, _ = time.Parse(time.RFC3339, v) }
+    if v, ok := promotion["end_date"].(string); ok { w.end, _ = time.Parse(time.RFC3339, v) }
+    return w
+}
+
+// ModifyPlan works out active when it is not overridden and warns about
+// windows that are already over or that overlap another promotion discounting
+// the same menu items. active keeps the value the API reported unless the
+// schedule changes. A new schedule only gives a known value when neither end
+// of the window is still to come; otherwise the window could open or close
+// before apply, and active is left for the API to report.
+func (r *promotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() { return }
+    var plan, state promotionResourceModel
+    var activeOverride types.Bool
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &activeOverride)...)
+    if !req.State.Raw.IsNull() { resp.Diagnostics.Append(req.State.Get(ctx, &state)...) }
+    if resp.Diagnostics.HasError() || plan.StartDate.IsUnknown() || plan.EndDate.IsUnknown() { return }
+
+    window := plan.window()
+    now := time.Now()
+    scheduleChanged := req.State.Raw.IsNull() || !plan.StartDate.Equal(state.StartDate) || !plan.EndDate.Equal(state.EndDate)
+    if activeOverride.IsNull() {
+        active := types.BoolUnknown()
+        switch {
+        case !scheduleChanged:
+            active = state.Active
+        case window.settled(now):
+            active = types.BoolValue(window.contains(now))
+        }
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), active)...)
+    }
+
+    if scheduleChanged && !window.end.IsZero() && !window.end.After(now) {
+        resp.Diagnostics.AddAttributeWarning(path.Root("end_date"), "Promotion Already Ended",
+            fmt.Sprintf("end_date %s is in the past, so the promotion will never run.", plan.EndDate.ValueString()))
+    }
+    if r.client == nil || plan.MenuItemIDs.IsUnknown() || (!scheduleChanged && plan.MenuItemIDs.Equal(state.MenuItemIDs)) { return }
+
+    var menuItemIDs []string
+    if !plan.MenuItemIDs.IsNull() { resp.Diagnostics.Append(plan.MenuItemIDs.ElementsAs(ctx, &menuItemIDs, false)...) }
+    promotions, err := r.client.ListAll("/promotions")
+    if err != nil { resp.Diagnostics.AddWarning("Unable to Check Overlapping Promotions", fmt.Sprintf("Unable to list promotions: %s", err)); return }
+    if overlapping := overlappingPromotions(promotions, plan.ID.ValueString(), window, menuItemIDs); len(overlapping) > 0 {
+        resp.Diagnostics.AddAttributeWarning(path.Root("menu_item_ids"), "Overlapping Promotions",
+            fmt.Sprintf("The promotion's window overlaps %s, which discount the same menu items. Customers may be able to combine the discounts.", strings.Join(overlapping, ", ")))
+    }
+}
+
+// overlappingPromotions returns the names of promotions other than id whose
+// window overlaps window and which discount any of menuItemIDs. A promotion
+// without menu items discounts every item.
+func overlappingPromotions(promotions []map[string]interface{}, id string, window promotionWindow, menuItemIDs []string) []string {
+    var names []string
+    for _, promotion := range promotions {
+        if promotion["id"] == id || !window.overlaps(promotionWindowFromAPI(promotion)) { continue }
+        others, _ := flattenStrings(promotion["menu_item_ids"], true)
+        shared := len(menuItemIDs) == 0 || len(others) == 0
+        for _, other := range others {
+            for _, menuItemID := range menuItemIDs {
+                if other == menuItemID { shared = true }
+            }
+        }
+        if !shared { continue }
+        name, _ := promotion["name"].(string)
+        names = append(names, fmt.Sprintf("%q", name))
+    }
+    sort.Strings(names)
+    return names
+}
+
+// requestBody builds the promotion request. active is only sent when
+// activeOverride is set; otherwise the API schedules the promotion from its
+// start and end dates.
+func (m *promotionResourceModel) requestBody(ctx context.Context, activeOverride types.Bool) (map[string]interface{}, diag.Diagnostics) {
+    var diags diag.Diagnostics
+    optionalString := func(v types.String) interface{} { if v.IsNull() { return nil }; return v.ValueString() }
+    optionalInt := func(v types.Int64) interface{} { if v.IsNull() { return nil }; return v.ValueInt64() }
+    body := map[string]interface{}{
+        "name":                     m.Name.ValueString(),
+        "description":              optionalString(m.Description),
+        "start_date":               optionalString(m.StartDate),
+        "end_date":                 optionalString(m.EndDate),
+        "discount_type":            optionalString(m.DiscountType),
+        "discount_value":           nil,
+        "free_menu_item_id":        optionalString(m.FreeMenuItemID),
+        "promo_code":               optionalString(m.PromoCode),
+        "usage_limit":              optionalInt(m.UsageLimit),
+        "usage_limit_per_customer": optionalInt(m.UsageLimitPerCustomer),
+    }
+    if !m.DiscountValue.IsNull() { body["discount_value"] = m.DiscountValue.ValueFloat64() }
+    body["active"] = nil
+    if !activeOverride.IsNull() && !activeOverride.IsUnknown() { body["active"] = activeOverride.ValueBool() }
+    var d diag.Diagnostics
+    body["store_ids"], d = expandStrings(ctx, m.StoreIDs)
+    diags.Append(d...)
+    body["regions"], d = expandStrings(ctx, m.Regions)
+    diags.Append(d...)
+    body["menu_item_ids"], d = expandStrings(ctx, m.MenuItemIDs)
+    diags.Append(d...)
+    return body, diags
+}
+
+// applyResponse copies the values the API computes or may normalise into the
+// model.
+func (m *promotionResourceModel) applyResponse(ctx context.Context, result map[string]interface{}) diag.Diagnostics {
+    var diags diag.Diagnostics
+    if v, ok := result["name"].(string); ok { m.Name = types.StringValue(v) }
+    if v, ok := result["description"].(string); ok { m.Description = types.StringValue(v) }
+    m.StartDate = sameInstantOrValue(m.StartDate, result["start_date"])
+    m.EndDate = sameInstantOrValue(m.EndDate, result["end_date"])
+    if v, ok := result["discount_type"].(string); ok && !m.DiscountType.IsNull() { m.DiscountType = types.StringValue(v) }
+    if v, ok := result["discount_value"].(float64); ok && !m.DiscountValue.IsNull() { m.DiscountValue = types.Float64Value(v) }
+    if v, ok := result["free_menu_item_id"].(string); ok { m.FreeMenuItemID = types.StringValue(v) }
+    if v, ok := result["promo_code"].(string); ok { m.PromoCode = types.StringValue(v) }
+    if v, ok := result["usage_limit"].(float64); ok { m.UsageLimit = types.Int64Value(int64(v)) }
+    if v, ok := result["usage_limit_per_customer"].(float64); ok { m.UsageLimitPerCustomer = types.Int64Value(int64(v)) }
+    m.RedemptionCount = types.Int64Value(0)
+    if v, ok := result["redemption_count"].(float64); ok { m.RedemptionCount = types.Int64Value(int64(v)) }
+    targets := []struct {
+        key   string
+        value *types.Set
+    }{{"store_ids", &m.StoreIDs}, {"regions", &m.Regions}, {"menu_item_ids", &m.MenuItemIDs}}
+    for _, target := range targets {
+        if values, ok := flattenStrings(result[target.key], target.value.IsNull()); ok {
+            value, d := types.SetValueFrom(ctx, types.StringType, values)
+            diags.Append(d...)
+            *target.value = value
+        }
+    }
+    return diags
+}
+
+// setComputedActive resolves an active left unknown at plan time, preferring
+// the value the API reports over the schedule.
+func (m *promotionResourceModel) setComputedActive(result map[string]interface{}) {
+    if !m.Active.IsUnknown() { return }
+    m.Active = types.BoolValue(m.window().contains(time.Now()))
+    if a, ok := result["active"].(bool); ok { m.Active = types.BoolValue(a) }
+}
+
+// sameInstantOrValue keeps a configured timestamp when the API returns the
+// same instant in another timezone, such as UTC.
+func sameInstantOrValue(prior types.String, raw interface{}) types.String {
+    v, ok := raw.(string)
+    if !ok { return prior }
+    current, err := time.Parse(time.RFC3339, v)
+    if previous, ok := parsePromotionTime(prior); ok && err == nil && previous.Equal(current) { return prior }
+    return types.StringValue(v)
+}
+
 func (r *promotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan promotionResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() { return }
-    body := map[string]interface{}{"name": plan.Name.ValueString(), "description": func() interface{} { if plan.Description.IsNull() { return nil }; return plan.Description.ValueString() }()}
+    var activeOverride types.Bool
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &activeOverride)...)
+    body, diags := plan.requestBody(ctx, activeOverride)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() { return }
     respBody, err := r.client.DoRequest("POST", "/promotions", body)
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create promotion: %s", err)); return }
     var result map[string]interface{}
     if err := json.Unmarshal(respBody, &result); err != nil { resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err)); return }
     if id, ok := result["id"].(string); ok { plan.ID = types.StringValue(id) }
+    resp.Diagnostics.Append(plan.applyResponse(ctx, result)...)
+    plan.setComputedActive(result)
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -69,7 +357,9 @@ func (r *promotionResource) Read(ctx context.Context, req resource.ReadRequest,
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read promotion: %s", err)); return }
     var result map[string]interface{}
     if err := json.Unmarshal(respBody, &result); err != nil { resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err)); return }
+    resp.Diagnostics.Append(state.applyResponse(ctx, result)...)
     if a, ok := result["active"].(bool); ok { state.Active = types.BoolValue(a) }
+    if resp.Diagnostics.HasError() { return }
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -77,9 +367,16 @@ func (r *promotionResource) Update(ctx context.Context, req resource.UpdateReque
     var plan promotionResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() { return }
-    body := map[string]interface{}{"name": plan.Name.ValueString()}
-    _, err := r.client.DoRequest("PUT", "/promotions/"+plan.ID.ValueString(), body)
+    var activeOverride types.Bool
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &activeOverride)...)
+    body, diags := plan.requestBody(ctx, activeOverride)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() { return }
+    respBody, err := r.client.DoRequest("PUT", "/promotions/"+plan.ID.ValueString(), body)
     if err != nil { resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update promotion: %s", err)); return }
+    var result map[string]interface{}
+    if json.Unmarshal(respBody, &result) != nil { result = nil }
+    plan.setComputedActive(result)
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
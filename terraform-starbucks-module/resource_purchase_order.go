@@ -0,0 +1,519 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "math"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &purchaseOrderResource{}
+var _ resource.ResourceWithImportState = &purchaseOrderResource{}
+var _ resource.ResourceWithValidateConfig = &purchaseOrderResource{}
+var _ resource.ResourceWithModifyPlan = &purchaseOrderResource{}
+
+const (
+    purchaseOrderDraft     = "draft"
+    purchaseOrderSubmitted = "submitted"
+    purchaseOrderReceived  = "received"
+)
+
+// purchaseOrderStates lists the lifecycle states in the only order an order
+// can move through them.
+var purchaseOrderStates = []string{purchaseOrderDraft, purchaseOrderSubmitted, purchaseOrderReceived}
+
+type purchaseOrderResource struct {
+    client *StarbucksClient
+}
+
+type purchaseOrderResourceModel struct {
+    ID                   types.String  `tfsdk:"id"`
+    StoreID              types.String  `tfsdk:"store_id"`
+    SupplierID           types.String  `tfsdk:"supplier_id"`
+    LineItems            types.List    `tfsdk:"line_items"`
+    ExpectedDeliveryDate types.String  `tfsdk:"expected_delivery_date"`
+    Status               types.String  `tfsdk:"status"`
+    TotalCost            types.Float64 `tfsdk:"total_cost"`
+    SubmittedAt          types.String  `tfsdk:"submitted_at"`
+    ReceivedAt           types.String  `tfsdk:"received_at"`
+}
+
+type purchaseOrderLineModel struct {
+    ItemSKU  types.String  `tfsdk:"item_sku"`
+    Quantity types.Int64   `tfsdk:"quantity"`
+    Unit     types.String  `tfsdk:"unit"`
+    UnitCost types.Float64 `tfsdk:"unit_cost"`
+}
+
+var purchaseOrderLineAttrTypes = map[string]attr.Type{
+    "item_sku":  types.StringType,
+    "quantity":  types.Int64Type,
+    "unit":      types.StringType,
+    "unit_cost": types.Float64Type,
+}
+
+func NewPurchaseOrderResource() resource.Resource {
+    return &purchaseOrderResource{}
+}
+
+func (r *purchaseOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_purchase_order"
+}
+
+func (r *purchaseOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a purchase order from a supplier for a store. Orders move from draft to submitted to received; line items can only change while the order is a draft.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the purchase order",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "ID of the store receiving the order",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "supplier_id": schema.StringAttribute{
+                Description: "ID of the supplier fulfilling the order",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "line_items": schema.ListNestedAttribute{
+                Description: "SKUs ordered",
+                Required:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "item_sku": schema.StringAttribute{
+                            Description: "SKU ordered",
+                            Required:    true,
+                        },
+                        "quantity": schema.Int64Attribute{
+                            Description: "Quantity ordered, in unit",
+                            Required:    true,
+                        },
+                        "unit": schema.StringAttribute{
+                            Description: "Unit of quantity. Defaults to count.",
+                            Optional:    true,
+                            Validators:  []validator.String{oneOfValidator{values: inventoryUnitNames()}},
+                        },
+                        "unit_cost": schema.Float64Attribute{
+                            Description: "Cost per unit (USD)",
+                            Optional:    true,
+                        },
+                    },
+                },
+            },
+            "expected_delivery_date": schema.StringAttribute{
+                Description: "Date the delivery is expected (YYYY-MM-DD format)",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "status": schema.StringAttribute{
+                Description: "Lifecycle state: draft, submitted or received. States can only move forward. When unset, new orders start as drafts and Terraform then follows the state reported by the API, e.g. an order received by the store.",
+                Optional:    true,
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+                Validators: []validator.String{oneOfValidator{values: purchaseOrderStates}},
+            },
+            "total_cost": schema.Float64Attribute{
+                Description: "Sum of quantity times unit_cost over all line items (USD)",
+                Computed:    true,
+                PlanModifiers: []planmodifier.Float64{
+                    float64planmodifier.UseStateForUnknown(),
+                },
+            },
+            "submitted_at": schema.StringAttribute{
+                Description: "Time the order was submitted to the supplier (RFC3339)",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "received_at": schema.StringAttribute{
+                Description: "Time the order was received by the store (RFC3339)",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+        },
+    }
+}
+
+func (r *purchaseOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *purchaseOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() || config.LineItems.IsNull() || config.LineItems.IsUnknown() {
+        return
+    }
+
+    var lines []purchaseOrderLineModel
+    resp.Diagnostics.Append(config.LineItems.ElementsAs(ctx, &lines, false)...)
+    if len(lines) == 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("line_items"), "Missing Line Items", "A purchase order needs at least one line item.")
+    }
+    seen := map[string]bool{}
+    for i, line := range lines {
+        at := path.Root("line_items").AtListIndex(i)
+        if !line.Quantity.IsUnknown() && line.Quantity.ValueInt64() <= 0 {
+            resp.Diagnostics.AddAttributeError(at.AtName("quantity"), "Invalid Quantity", "Line item quantity must be greater than zero.")
+        }
+        if !line.UnitCost.IsNull() && !line.UnitCost.IsUnknown() && line.UnitCost.ValueFloat64() < 0 {
+            resp.Diagnostics.AddAttributeError(at.AtName("unit_cost"), "Invalid Unit Cost", "unit_cost cannot be negative.")
+        }
+        if line.ItemSKU.IsUnknown() {
+            continue
+        }
+        if seen[line.ItemSKU.ValueString()] {
+            resp.Diagnostics.AddAttributeError(at.AtName("item_sku"), "Duplicate Line Item", fmt.Sprintf("SKU %q is ordered more than once.", line.ItemSKU.ValueString()))
+        }
+        seen[line.ItemSKU.ValueString()] = true
+    }
+}
+
+func purchaseOrderStateIndex(status string) int {
+    for i, s := range purchaseOrderStates {
+        if s == status {
+            return i
+        }
+    }
+    return -1
+}
+
+// ModifyPlan rejects moving an order back to an earlier state and changing
+// an order that has left draft. An unset status follows the state, so only a
+// status set in config can move an order back.
+func (r *purchaseOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var state, plan purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !plan.LineItems.Equal(state.LineItems) {
+        total := types.Float64Unknown()
+        if !plan.LineItems.IsUnknown() {
+            total = types.Float64Value(purchaseOrderTotal(ctx, plan.LineItems))
+        }
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("total_cost"), total)...)
+    }
+    if plan.Status.IsUnknown() {
+        return
+    }
+    if !plan.Status.Equal(state.Status) {
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("submitted_at"), types.StringUnknown())...)
+        resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("received_at"), types.StringUnknown())...)
+    }
+
+    if purchaseOrderStateIndex(plan.Status.ValueString()) < purchaseOrderStateIndex(state.Status.ValueString()) {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("status"),
+            "Illegal Purchase Order Transition",
+            fmt.Sprintf("Purchase order %s is %s and cannot move back to %s. If it was advanced outside Terraform, set status to %s or remove it from the configuration.",
+                state.ID.ValueString(), state.Status.ValueString(), plan.Status.ValueString(), state.Status.ValueString()),
+        )
+    }
+    if state.Status.ValueString() != purchaseOrderDraft {
+        if !plan.LineItems.Equal(state.LineItems) {
+            resp.Diagnostics.AddAttributeError(path.Root("line_items"), "Purchase Order Locked",
+                fmt.Sprintf("Line items of purchase order %s cannot change because it is %s.", state.ID.ValueString(), state.Status.ValueString()))
+        }
+        if !plan.ExpectedDeliveryDate.Equal(state.ExpectedDeliveryDate) && state.Status.ValueString() == purchaseOrderReceived {
+            resp.Diagnostics.AddAttributeError(path.Root("expected_delivery_date"), "Purchase Order Locked",
+                fmt.Sprintf("Purchase order %s has already been received.", state.ID.ValueString()))
+        }
+    }
+}
+
+func (r *purchaseOrderResource) requestBody(ctx context.Context, plan purchaseOrderResourceModel) (map[string]interface{}, diag.Diagnostics) {
+    var lines []purchaseOrderLineModel
+    diags := plan.LineItems.ElementsAs(ctx, &lines, false)
+    rawLines := make([]interface{}, 0, len(lines))
+    for _, line := range lines {
+        entry := map[string]interface{}{"item_sku": line.ItemSKU.ValueString(), "quantity": line.Quantity.ValueInt64(), "unit": "count"}
+        if !line.Unit.IsNull() {
+            entry["unit"] = line.Unit.ValueString()
+        }
+        if !line.UnitCost.IsNull() {
+            entry["unit_cost"] = line.UnitCost.ValueFloat64()
+        }
+        rawLines = append(rawLines, entry)
+    }
+    body := map[string]interface{}{
+        "store_id":               plan.StoreID.ValueString(),
+        "supplier_id":            plan.SupplierID.ValueString(),
+        "line_items":             rawLines,
+        "expected_delivery_date": nil,
+    }
+    if !plan.ExpectedDeliveryDate.IsNull() {
+        body["expected_delivery_date"] = plan.ExpectedDeliveryDate.ValueString()
+    }
+    return body, diags
+}
+
+// purchaseOrderTotal adds up the cost of the line items, rounded to cents.
+func purchaseOrderTotal(ctx context.Context, lineItems types.List) float64 {
+    var lines []purchaseOrderLineModel
+    if lineItems.ElementsAs(ctx, &lines, false).HasError() {
+        return 0
+    }
+    total := 0.0
+    for _, line := range lines {
+        total += float64(line.Quantity.ValueInt64()) * line.UnitCost.ValueFloat64()
+    }
+    return math.Round(total*100) / 100
+}
+
+// advance moves an order forward one state at a time until it reaches the
+// planned state, recording the timestamps returned by the API.
+func (r *purchaseOrderResource) advance(from string, plan *purchaseOrderResourceModel) error {
+    actions := map[string]string{purchaseOrderSubmitted: "submit", purchaseOrderReceived: "receive"}
+    for i := purchaseOrderStateIndex(from) + 1; i <= purchaseOrderStateIndex(plan.Status.ValueString()); i++ {
+        next := purchaseOrderStates[i]
+        respBody, err := r.client.DoRequest("POST", "/purchase_orders/"+plan.ID.ValueString()+"/"+actions[next], nil)
+        if err != nil {
+            plan.Status = types.StringValue(purchaseOrderStates[i-1])
+            return fmt.Errorf("unable to move purchase order to %s: %w", next, err)
+        }
+        var result map[string]interface{}
+        if json.Unmarshal(respBody, &result) == nil {
+            plan.setTimestamps(result)
+        }
+    }
+    return nil
+}
+
+func (m *purchaseOrderResourceModel) setTimestamps(result map[string]interface{}) {
+    if val, ok := result["submitted_at"].(string); ok {
+        m.SubmittedAt = types.StringValue(val)
+    }
+    if val, ok := result["received_at"].(string); ok {
+        m.ReceivedAt = types.StringValue(val)
+    }
+}
+
+func (r *purchaseOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", "/purchase_orders", requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create purchase order: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+    plan.TotalCost = types.Float64Value(purchaseOrderTotal(ctx, plan.LineItems))
+    plan.SubmittedAt = types.StringNull()
+    plan.ReceivedAt = types.StringNull()
+    if plan.Status.IsUnknown() {
+        plan.Status = types.StringValue(purchaseOrderDraft)
+    }
+
+    if err := r.advance(purchaseOrderDraft, &plan); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set status of purchase order %s: %s", plan.ID.ValueString(), err))
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *purchaseOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", "/purchase_orders/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read purchase order: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["store_id"].(string); ok {
+        state.StoreID = types.StringValue(val)
+    }
+    if val, ok := result["supplier_id"].(string); ok {
+        state.SupplierID = types.StringValue(val)
+    }
+    if val, ok := result["status"].(string); ok {
+        state.Status = types.StringValue(val)
+    }
+    state.ExpectedDeliveryDate = types.StringNull()
+    if val, ok := result["expected_delivery_date"].(string); ok {
+        state.ExpectedDeliveryDate = types.StringValue(val)
+    }
+    if raw, ok := result["line_items"].([]interface{}); ok {
+        lineItems, diags := flattenPurchaseOrderLines(ctx, state.LineItems, raw)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        state.LineItems = lineItems
+    }
+    state.TotalCost = types.Float64Value(purchaseOrderTotal(ctx, state.LineItems))
+    state.setTimestamps(result)
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// flattenPurchaseOrderLines converts the API line items. unit is only kept
+// when it was configured or is not the default count.
+func flattenPurchaseOrderLines(ctx context.Context, prior types.List, raw []interface{}) (types.List, diag.Diagnostics) {
+    var priorLines []purchaseOrderLineModel
+    if !prior.IsNull() && !prior.IsUnknown() {
+        if diags := prior.ElementsAs(ctx, &priorLines, false); diags.HasError() {
+            return prior, diags
+        }
+    }
+    configuredUnit := map[string]bool{}
+    for _, line := range priorLines {
+        configuredUnit[line.ItemSKU.ValueString()] = !line.Unit.IsNull()
+    }
+
+    lines := make([]purchaseOrderLineModel, 0, len(raw))
+    for _, e := range raw {
+        entry, ok := e.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        line := purchaseOrderLineModel{ItemSKU: types.StringNull(), Quantity: types.Int64Null(), Unit: types.StringNull(), UnitCost: types.Float64Null()}
+        if val, ok := entry["item_sku"].(string); ok {
+            line.ItemSKU = types.StringValue(val)
+        }
+        if val, ok := entry["quantity"].(float64); ok {
+            line.Quantity = types.Int64Value(int64(val))
+        }
+        if val, ok := entry["unit"].(string); ok && (val != "count" || configuredUnit[line.ItemSKU.ValueString()]) {
+            line.Unit = types.StringValue(val)
+        }
+        if val, ok := entry["unit_cost"].(float64); ok {
+            line.UnitCost = types.Float64Value(val)
+        }
+        lines = append(lines, line)
+    }
+    return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: purchaseOrderLineAttrTypes}, lines)
+}
+
+func (r *purchaseOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan, state purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if state.Status.ValueString() != purchaseOrderReceived {
+        requestBody, diags := r.requestBody(ctx, plan)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        if state.Status.ValueString() != purchaseOrderDraft {
+            delete(requestBody, "line_items")
+        }
+        _, err := r.client.DoRequest("PUT", "/purchase_orders/"+plan.ID.ValueString(), requestBody)
+        if err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update purchase order: %s", err))
+            return
+        }
+    }
+    plan.TotalCost = types.Float64Value(purchaseOrderTotal(ctx, plan.LineItems))
+    plan.SubmittedAt = state.SubmittedAt
+    plan.ReceivedAt = state.ReceivedAt
+
+    if err := r.advance(state.Status.ValueString(), &plan); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set status of purchase order %s: %s", plan.ID.ValueString(), err))
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *purchaseOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state purchaseOrderResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    switch state.Status.ValueString() {
+    case purchaseOrderReceived:
+        resp.Diagnostics.AddWarning("Purchase Order Kept",
+            fmt.Sprintf("Purchase order %s has been received and was only removed from Terraform state.", state.ID.ValueString()))
+        return
+    case purchaseOrderSubmitted:
+        if _, err := r.client.DoRequest("POST", "/purchase_orders/"+state.ID.ValueString()+"/cancel", nil); err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel purchase order: %s", err))
+        }
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", "/purchase_orders/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete purchase order: %s", err))
+        return
+    }
+}
+
+func (r *purchaseOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
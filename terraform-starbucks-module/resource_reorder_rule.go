@@ -0,0 +1,272 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &reorderRuleResource{}
+var _ resource.ResourceWithImportState = &reorderRuleResource{}
+var _ resource.ResourceWithValidateConfig = &reorderRuleResource{}
+
+type reorderRuleResource struct {
+    client *StarbucksClient
+}
+
+type reorderRuleResourceModel struct {
+    ID              types.String `tfsdk:"id"`
+    ItemSKU         types.String `tfsdk:"item_sku"`
+    StoreID         types.String `tfsdk:"store_id"`
+    Region          types.String `tfsdk:"region"`
+    ReorderPoint    types.Int64  `tfsdk:"reorder_point"`
+    ReorderQuantity types.Int64  `tfsdk:"reorder_quantity"`
+    Unit            types.String `tfsdk:"unit"`
+    SupplierID      types.String `tfsdk:"supplier_id"`
+    LeadTimeDays    types.Int64  `tfsdk:"lead_time_days"`
+    Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+func NewReorderRuleResource() resource.Resource {
+    return &reorderRuleResource{}
+}
+
+func (r *reorderRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_reorder_rule"
+}
+
+func (r *reorderRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages an automatic reorder rule. When stock of the SKU falls to the reorder point at a store in scope, a purchase order for the reorder quantity is raised with the supplier.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the reorder rule",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "item_sku": schema.StringAttribute{
+                Description: "SKU the rule replenishes",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "Store the rule applies to. Conflicts with region.",
+                Optional:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "region": schema.StringAttribute{
+                Description: "Region whose stores the rule applies to. Conflicts with store_id.",
+                Optional:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "reorder_point": schema.Int64Attribute{
+                Description: "Stock level, in unit, at or below which an order is raised",
+                Required:    true,
+            },
+            "reorder_quantity": schema.Int64Attribute{
+                Description: "Quantity, in unit, ordered each time",
+                Required:    true,
+            },
+            "unit": schema.StringAttribute{
+                Description: "Unit of reorder_point and reorder_quantity. Defaults to the unit the store stocks the SKU in.",
+                Optional:    true,
+                Validators:  []validator.String{oneOfValidator{values: inventoryUnitNames()}},
+            },
+            "supplier_id": schema.StringAttribute{
+                Description: "ID of the supplier orders are placed with",
+                Required:    true,
+            },
+            "lead_time_days": schema.Int64Attribute{
+                Description: "Days between placing an order and delivery",
+                Required:    true,
+            },
+            "enabled": schema.BoolAttribute{
+                Description: "Whether the rule raises orders. Defaults to true.",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(true),
+            },
+        },
+    }
+}
+
+func (r *reorderRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *reorderRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config reorderRuleResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.StoreID.IsUnknown() && !config.Region.IsUnknown() && config.StoreID.IsNull() == config.Region.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("store_id"), "Invalid Reorder Rule Scope", "Exactly one of store_id or region must be set.")
+    }
+    if !config.ReorderPoint.IsUnknown() && config.ReorderPoint.ValueInt64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("reorder_point"), "Invalid Reorder Point", "reorder_point cannot be negative.")
+    }
+    if !config.ReorderQuantity.IsUnknown() && config.ReorderQuantity.ValueInt64() <= 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("reorder_quantity"), "Invalid Reorder Quantity", "reorder_quantity must be greater than zero.")
+    }
+    if !config.LeadTimeDays.IsUnknown() && config.LeadTimeDays.ValueInt64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("lead_time_days"), "Invalid Lead Time", "lead_time_days cannot be negative.")
+    }
+}
+
+func (m *reorderRuleResourceModel) requestBody() map[string]interface{} {
+    body := map[string]interface{}{
+        "item_sku":         m.ItemSKU.ValueString(),
+        "reorder_point":    m.ReorderPoint.ValueInt64(),
+        "reorder_quantity": m.ReorderQuantity.ValueInt64(),
+        "supplier_id":      m.SupplierID.ValueString(),
+        "lead_time_days":   m.LeadTimeDays.ValueInt64(),
+        "enabled":          m.Enabled.ValueBool(),
+        "unit":             nil,
+    }
+    if !m.StoreID.IsNull() {
+        body["store_id"] = m.StoreID.ValueString()
+    }
+    if !m.Region.IsNull() {
+        body["region"] = m.Region.ValueString()
+    }
+    if !m.Unit.IsNull() {
+        body["unit"] = m.Unit.ValueString()
+    }
+    return body
+}
+
+func (r *reorderRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan reorderRuleResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", "/reorder_rules", plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create reorder rule: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *reorderRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state reorderRuleResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", "/reorder_rules/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reorder rule: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["item_sku"].(string); ok {
+        state.ItemSKU = types.StringValue(val)
+    }
+    if val, ok := result["store_id"].(string); ok {
+        state.StoreID = types.StringValue(val)
+    }
+    if val, ok := result["region"].(string); ok {
+        state.Region = types.StringValue(val)
+    }
+    if val, ok := result["reorder_point"].(float64); ok {
+        state.ReorderPoint = types.Int64Value(int64(val))
+    }
+    if val, ok := result["reorder_quantity"].(float64); ok {
+        state.ReorderQuantity = types.Int64Value(int64(val))
+    }
+    if val, ok := result["unit"].(string); ok && !state.Unit.IsNull() {
+        state.Unit = types.StringValue(val)
+    }
+    if val, ok := result["supplier_id"].(string); ok {
+        state.SupplierID = types.StringValue(val)
+    }
+    if val, ok := result["lead_time_days"].(float64); ok {
+        state.LeadTimeDays = types.Int64Value(int64(val))
+    }
+    if val, ok := result["enabled"].(bool); ok {
+        state.Enabled = types.BoolValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *reorderRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan reorderRuleResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", "/reorder_rules/"+plan.ID.ValueString(), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update reorder rule: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *reorderRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state reorderRuleResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", "/reorder_rules/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete reorder rule: %s", err))
+        return
+    }
+}
+
+func (r *reorderRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
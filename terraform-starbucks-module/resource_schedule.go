@@ -0,0 +1,546 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &scheduleResource{}
+var _ resource.ResourceWithImportState = &scheduleResource{}
+var _ resource.ResourceWithValidateConfig = &scheduleResource{}
+var _ resource.ResourceWithModifyPlan = &scheduleResource{}
+
+// supervisorPositions are the positions that count towards supervisor
+// coverage regardless of is_shift_supervisor.
+var supervisorPositions = map[string]bool{
+    "shift_supervisor":  true,
+    "assistant_manager": true,
+    "store_manager":     true,
+}
+
+type scheduleResource struct {
+    client *StarbucksClient
+}
+
+type scheduleResourceModel struct {
+    ID             types.String `tfsdk:"id"`
+    StoreID        types.String `tfsdk:"store_id"`
+    WeekStart      types.String `tfsdk:"week_start"`
+    MinSupervisors types.Int64  `tfsdk:"min_supervisors"`
+    Shifts         types.List   `tfsdk:"shifts"`
+}
+
+type scheduleShiftModel struct {
+    EmployeeID types.String `tfsdk:"employee_id"`
+    Day        types.String `tfsdk:"day"`
+    Start      types.String `tfsdk:"start"`
+    End        types.String `tfsdk:"end"`
+}
+
+var scheduleShiftAttrTypes = map[string]attr.Type{
+    "employee_id": types.StringType,
+    "day":         types.StringType,
+    "start":       types.StringType,
+    "end":         types.StringType,
+}
+
+func NewScheduleResource() resource.Resource {
+    return &scheduleResource{}
+}
+
+func (r *scheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages the weekly shift schedule of a store, assigning employees to time slots. Shifts are validated against the store's weekly_hours, each employee's weekly_hours availability and a minimum supervisor coverage rule.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the schedule",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "ID of the scheduled store",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "week_start": schema.StringAttribute{
+                Description: "Monday the schedule week starts on (YYYY-MM-DD format)",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+                Validators: []validator.String{dateValidator{}},
+            },
+            "min_supervisors": schema.Int64Attribute{
+                Description: "Minimum number of supervisors that must be on shift whenever the store is open. Defaults to 1; set to 0 to disable the rule.",
+                Optional:    true,
+                Computed:    true,
+                Default:     int64default.StaticInt64(1),
+            },
+            "shifts": schema.ListNestedAttribute{
+                Description: "Shifts worked during the week",
+                Required:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "employee_id": schema.StringAttribute{
+                            Description: "ID of the employee working the shift",
+                            Required:    true,
+                        },
+                        "day": schema.StringAttribute{
+                            Description: "Weekday of the shift (monday-sunday)",
+                            Required:    true,
+                            Validators: []validator.String{
+                                oneOfValidator{values: weekdays},
+                            },
+                        },
+                        "start": schema.StringAttribute{
+                            Description: "Shift start time in 24-hour HH:MM format",
+                            Required:    true,
+                            Validators:  []validator.String{clockValidator{}},
+                        },
+                        "end": schema.StringAttribute{
+                            Description: "Shift end time in 24-hour HH:MM format",
+                            Required:    true,
+                            Validators:  []validator.String{clockValidator{}},
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (r *scheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *scheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config scheduleResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.WeekStart.IsNull() && !config.WeekStart.IsUnknown() {
+        if weekStart, err := time.Parse(dateLayout, config.WeekStart.ValueString()); err == nil && weekStart.Weekday() != time.Monday {
+            resp.Diagnostics.AddAttributeError(path.Root("week_start"), "Invalid Week Start",
+                fmt.Sprintf("week_start %s is a %s, expected a Monday", config.WeekStart.ValueString(), weekStart.Weekday()))
+        }
+    }
+    if !config.MinSupervisors.IsUnknown() && config.MinSupervisors.ValueInt64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("min_supervisors"), "Invalid Minimum Supervisors", "min_supervisors cannot be negative.")
+    }
+
+    if config.Shifts.IsUnknown() {
+        return
+    }
+    var shifts []scheduleShiftModel
+    resp.Diagnostics.Append(config.Shifts.ElementsAs(ctx, &shifts, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    type booked struct {
+        index      int
+        start, end int
+    }
+    bookings := map[string][]booked{}
+    for i, shift := range shifts {
+        if shift.Start.IsUnknown() || shift.End.IsUnknown() {
+            continue
+        }
+        start, startErr := parseClock(shift.Start.ValueString())
+        end, endErr := parseClock(shift.End.ValueString())
+        if startErr != nil || endErr != nil {
+            continue
+        }
+        if start >= end {
+            resp.Diagnostics.AddAttributeError(path.Root("shifts").AtListIndex(i), "Invalid Shift",
+                fmt.Sprintf("Shift start %s is not before its end %s.", shift.Start.ValueString(), shift.End.ValueString()))
+            continue
+        }
+        if shift.EmployeeID.IsUnknown() || shift.Day.IsUnknown() {
+            continue
+        }
+
+        key := shift.EmployeeID.ValueString() + "/" + shift.Day.ValueString()
+        for _, other := range bookings[key] {
+            if start < other.end && other.start < end {
+                resp.Diagnostics.AddAttributeError(path.Root("shifts").AtListIndex(i), "Overlapping Shifts",
+                    fmt.Sprintf("Employee %s is already scheduled on %s by shift %d.", shift.EmployeeID.ValueString(), shift.Day.ValueString(), other.index))
+            }
+        }
+        bookings[key] = append(bookings[key], booked{index: i, start: start, end: end})
+    }
+}
+
+func (r *scheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.Plan.Raw.IsNull() || r.client == nil {
+        return
+    }
+
+    var plan scheduleResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() || plan.StoreID.IsUnknown() || plan.Shifts.IsUnknown() || plan.MinSupervisors.IsUnknown() {
+        return
+    }
+
+    // Only check a schedule when it changes, so later drift in store or
+    // employee hours does not block unrelated applies.
+    if !req.State.Raw.IsNull() {
+        var state scheduleResourceModel
+        resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        if plan.Shifts.Equal(state.Shifts) && plan.StoreID.Equal(state.StoreID) && plan.MinSupervisors.Equal(state.MinSupervisors) {
+            return
+        }
+    }
+
+    var shifts []scheduleShiftModel
+    resp.Diagnostics.Append(plan.Shifts.ElementsAs(ctx, &shifts, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+    for _, shift := range shifts {
+        if shift.EmployeeID.IsUnknown() || shift.Day.IsUnknown() || shift.Start.IsUnknown() || shift.End.IsUnknown() {
+            return
+        }
+    }
+
+    store, err := r.getObject("/stores/" + plan.StoreID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddAttributeWarning(path.Root("store_id"), "Unable to Check Schedule", fmt.Sprintf("Unable to read store: %s", err))
+        return
+    }
+    storeHours, hasStoreHours := store["weekly_hours"].(map[string]interface{})
+    if !hasStoreHours {
+        resp.Diagnostics.AddAttributeWarning(path.Root("store_id"), "Store Has No Weekly Hours",
+            fmt.Sprintf("Store %s has no weekly_hours, so shifts cannot be checked against its opening hours or supervisor coverage.", plan.StoreID.ValueString()))
+    }
+
+    employees := map[string]map[string]interface{}{}
+    for _, shift := range shifts {
+        id := shift.EmployeeID.ValueString()
+        if _, ok := employees[id]; ok {
+            continue
+        }
+        employee, err := r.getObject("/employees/" + id)
+        if err != nil {
+            resp.Diagnostics.AddAttributeWarning(path.Root("shifts"), "Unable to Check Schedule", fmt.Sprintf("Unable to read employee %s: %s", id, err))
+            return
+        }
+        employees[id] = employee
+    }
+
+    for i, shift := range shifts {
+        day := shift.Day.ValueString()
+        start, _ := parseClock(shift.Start.ValueString())
+        end, _ := parseClock(shift.End.ValueString())
+        shiftPath := path.Root("shifts").AtListIndex(i)
+
+        if hasStoreHours {
+            open, closeAt, isOpen := hoursOn(storeHours, day)
+            if !isOpen {
+                resp.Diagnostics.AddAttributeError(shiftPath, "Shift Outside Opening Hours",
+                    fmt.Sprintf("Store %s is closed on %s.", plan.StoreID.ValueString(), day))
+            } else if start < open || end > closeAt {
+                resp.Diagnostics.AddAttributeError(shiftPath, "Shift Outside Opening Hours",
+                    fmt.Sprintf("Shift %s-%s on %s is outside the store's opening hours %s-%s.", formatClock(start), formatClock(end), day, formatClock(open), formatClock(closeAt)))
+            }
+        }
+
+        if availability, ok := employees[shift.EmployeeID.ValueString()]["weekly_hours"].(map[string]interface{}); ok {
+            from, until, available := hoursOn(availability, day)
+            if !available || start < from || end > until {
+                resp.Diagnostics.AddAttributeError(shiftPath, "Employee Unavailable",
+                    fmt.Sprintf("Employee %s is not available %s-%s on %s.", shift.EmployeeID.ValueString(), formatClock(start), formatClock(end), day))
+            }
+        }
+    }
+
+    if !hasStoreHours || plan.MinSupervisors.ValueInt64() == 0 {
+        return
+    }
+
+    for _, day := range weekdays {
+        open, closeAt, isOpen := hoursOn(storeHours, day)
+        if !isOpen {
+            continue
+        }
+        var supervisorShifts [][2]int
+        for _, shift := range shifts {
+            if shift.Day.ValueString() != day || !isSupervisor(employees[shift.EmployeeID.ValueString()]) {
+                continue
+            }
+            start, _ := parseClock(shift.Start.ValueString())
+            end, _ := parseClock(shift.End.ValueString())
+            supervisorShifts = append(supervisorShifts, [2]int{start, end})
+        }
+        if from, until, covered := uncoveredWindow(open, closeAt, supervisorShifts, int(plan.MinSupervisors.ValueInt64())); !covered {
+            resp.Diagnostics.AddAttributeError(path.Root("shifts"), "Insufficient Supervisor Coverage",
+                fmt.Sprintf("Fewer than %d supervisor(s) are on shift on %s between %s and %s.", plan.MinSupervisors.ValueInt64(), day, formatClock(from), formatClock(until)))
+        }
+    }
+}
+
+func (r *scheduleResource) getObject(requestPath string) (map[string]interface{}, error) {
+    respBody, err := r.client.DoRequest("GET", requestPath, nil)
+    if err != nil {
+        return nil, err
+    }
+    return decodeObject(respBody)
+}
+
+// hoursOn returns the open and close minutes of day in API weekly_hours.
+func hoursOn(weeklyHours map[string]interface{}, day string) (int, int, bool) {
+    entry, ok := weeklyHours[day].(map[string]interface{})
+    if !ok {
+        return 0, 0, false
+    }
+    openStr, _ := entry["open"].(string)
+    closeStr, _ := entry["close"].(string)
+    open, err := parseClock(openStr)
+    if err != nil {
+        return 0, 0, false
+    }
+    closeAt, err := parseClock(closeStr)
+    if err != nil {
+        return 0, 0, false
+    }
+    return open, closeAt, true
+}
+
+func isSupervisor(employee map[string]interface{}) bool {
+    if v, ok := employee["is_shift_supervisor"].(bool); ok && v {
+        return true
+    }
+    position, _ := employee["position"].(string)
+    return supervisorPositions[position]
+}
+
+// uncoveredWindow returns the first window between open and closeAt during
+// which fewer than min shifts are running, or covered=true if there is none.
+func uncoveredWindow(open, closeAt int, shifts [][2]int, required int) (from int, until int, covered bool) {
+    points := []int{open, closeAt}
+    for _, s := range shifts {
+        if s[0] > open && s[0] < closeAt {
+            points = append(points, s[0])
+        }
+        if s[1] > open && s[1] < closeAt {
+            points = append(points, s[1])
+        }
+    }
+    sort.Ints(points)
+
+    for i := 0; i+1 < len(points); i++ {
+        if points[i] == points[i+1] {
+            continue
+        }
+        running := 0
+        for _, s := range shifts {
+            if s[0] <= points[i] && s[1] >= points[i+1] {
+                running++
+            }
+        }
+        if running < required {
+            return points[i], points[i+1], false
+        }
+    }
+    return 0, 0, true
+}
+
+func expandScheduleShifts(ctx context.Context, value types.List) ([]interface{}, diag.Diagnostics) {
+    var shifts []scheduleShiftModel
+    diags := value.ElementsAs(ctx, &shifts, false)
+    result := make([]interface{}, 0, len(shifts))
+    for _, shift := range shifts {
+        result = append(result, map[string]interface{}{
+            "employee_id": shift.EmployeeID.ValueString(),
+            "day":         shift.Day.ValueString(),
+            "start":       normalizeClock(shift.Start.ValueString()),
+            "end":         normalizeClock(shift.End.ValueString()),
+        })
+    }
+    return result, diags
+}
+
+// flattenScheduleShifts converts API shifts back into a list, keeping prior
+// values whose times only differ in formatting.
+func flattenScheduleShifts(ctx context.Context, prior types.List, raw []interface{}) (types.List, diag.Diagnostics) {
+    var priorShifts []scheduleShiftModel
+    if !prior.IsNull() && !prior.IsUnknown() {
+        if diags := prior.ElementsAs(ctx, &priorShifts, false); diags.HasError() {
+            return prior, diags
+        }
+    }
+
+    shifts := make([]scheduleShiftModel, 0, len(raw))
+    for i, v := range raw {
+        entry, ok := v.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        employeeID, _ := entry["employee_id"].(string)
+        day, _ := entry["day"].(string)
+        start, _ := entry["start"].(string)
+        end, _ := entry["end"].(string)
+
+        if i < len(priorShifts) {
+            p := priorShifts[i]
+            if p.EmployeeID.ValueString() == employeeID && p.Day.ValueString() == day &&
+                normalizeClock(p.Start.ValueString()) == normalizeClock(start) &&
+                normalizeClock(p.End.ValueString()) == normalizeClock(end) {
+                shifts = append(shifts, p)
+                continue
+            }
+        }
+        shifts = append(shifts, scheduleShiftModel{
+            EmployeeID: types.StringValue(employeeID),
+            Day:        types.StringValue(strings.ToLower(day)),
+            Start:      types.StringValue(normalizeClock(start)),
+            End:        types.StringValue(normalizeClock(end)),
+        })
+    }
+    return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scheduleShiftAttrTypes}, shifts)
+}
+
+func (r *scheduleResource) requestBody(ctx context.Context, plan scheduleResourceModel) (map[string]interface{}, diag.Diagnostics) {
+    shifts, diags := expandScheduleShifts(ctx, plan.Shifts)
+    return map[string]interface{}{
+        "week_start":      plan.WeekStart.ValueString(),
+        "min_supervisors": plan.MinSupervisors.ValueInt64(),
+        "shifts":          shifts,
+    }, diags
+}
+
+func (r *scheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan scheduleResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.CreateSchedule(plan.StoreID.ValueString(), requestBody)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state scheduleResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    result, err := r.client.GetSchedule(state.StoreID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule: %s", err))
+        return
+    }
+
+    if val, ok := result["week_start"].(string); ok {
+        state.WeekStart = types.StringValue(val)
+    }
+    if val, ok := result["min_supervisors"].(float64); ok {
+        state.MinSupervisors = types.Int64Value(int64(val))
+    }
+    if val, ok := result["shifts"].([]interface{}); ok {
+        shifts, diags := flattenScheduleShifts(ctx, state.Shifts, val)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        state.Shifts = shifts
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan scheduleResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    requestBody, diags := r.requestBody(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if err := r.client.UpdateSchedule(plan.StoreID.ValueString(), plan.ID.ValueString(), requestBody); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *scheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state scheduleResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if err := r.client.DeleteSchedule(state.StoreID.ValueString(), state.ID.ValueString()); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schedule: %s", err))
+        return
+    }
+}
+
+func (r *scheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    parts := strings.Split(req.ID, "/")
+    if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+        resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form store_id/schedule_id, got: %q", req.ID))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -5,17 +5,27 @@ import (
     "encoding/json"
     "fmt"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &storeResource{}
 var _ resource.ResourceWithImportState = &storeResource{}
+var _ resource.ResourceWithValidateConfig = &storeResource{}
+var _ resource.ResourceWithModifyPlan = &storeResource{}
+
+const (
+    storeStatusActive            = "active"
+    storeStatusTemporarilyClosed = "temporarily_closed"
+    storeStatusPermanentlyClosed = "permanently_closed"
+)
 
 type storeResource struct {
     client *StarbucksClient
@@ -34,6 +44,8 @@ type storeResourceModel struct {
     Latitude      types.Float64 `tfsdk:"latitude"`
     Longitude     types.Float64 `tfsdk:"longitude"`
     OpeningHours  types.String `tfsdk:"opening_hours"`
+    WeeklyHours   types.Map    `tfsdk:"weekly_hours"`
+    Timezone      types.String `tfsdk:"timezone"`
     HasDriveThru  types.Bool   `tfsdk:"has_drive_thru"`
     HasWifi       types.Bool   `tfsdk:"has_wifi"`
     HasMobileOrder types.Bool  `tfsdk:"has_mobile_order"`
@@ -41,6 +53,9 @@ type storeResourceModel struct {
     StoreType     types.String `tfsdk:"store_type"`
     ManagerEmail  types.String `tfsdk:"manager_email"`
     Status        types.String `tfsdk:"status"`
+    StatusReason  types.String `tfsdk:"status_reason"`
+    ReopeningDate types.String `tfsdk:"reopening_date"`
+    DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 func NewStoreResource() resource.Resource {
@@ -103,9 +118,12 @@ func (r *storeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
                 Optional:    true,
             },
             "opening_hours": schema.StringAttribute{
-                Description: "Store opening hours (e.g., 'Mon-Fri: 6AM-9PM, Sat-Sun: 7AM-8PM')",
-                Optional:    true,
+                Description:        "Store opening hours (e.g., 'Mon-Fri: 6AM-9PM, Sat-Sun: 7AM-8PM')",
+                Optional:           true,
+                DeprecationMessage: "Use weekly_hours instead. opening_hours is free-form and cannot be validated.",
             },
+            "weekly_hours": weeklyHoursSchemaAttribute("Structured store opening hours."),
+            "timezone":     timezoneSchemaAttribute("IANA time zone the weekly_hours are expressed in (e.g., America/Los_Angeles)"),
             "has_drive_thru": schema.BoolAttribute{
                 Description: "Whether store has drive-thru service",
                 Optional:    true,
@@ -139,8 +157,30 @@ func (r *storeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
                 Optional:    true,
             },
             "status": schema.StringAttribute{
-                Description: "Store status: active, temporarily_closed, permanently_closed",
+                Description: "Store status: active, temporarily_closed, permanently_closed. A permanently closed store cannot be reopened. When unset, Terraform leaves the status alone and tracks whatever the API reports.",
+                Optional:    true,
                 Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+                Validators: []validator.String{
+                    oneOfValidator{values: []string{storeStatusActive, storeStatusTemporarilyClosed, storeStatusPermanentlyClosed}},
+                },
+            },
+            "status_reason": schema.StringAttribute{
+                Description: "Reason for closing the store (e.g., 'Renovation')",
+                Optional:    true,
+            },
+            "reopening_date": schema.StringAttribute{
+                Description: "Planned reopening date of a temporarily closed store (YYYY-MM-DD format)",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "deletion_protection": schema.BoolAttribute{
+                Description: "Whether Terraform is prevented from destroying the store. Must be set to false and applied before the store can be destroyed.",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
             },
         },
     }
@@ -163,6 +203,80 @@ func (r *storeResource) Configure(_ context.Context, req resource.ConfigureReque
     r.client = client
 }
 
+func (r *storeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config storeResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.OpeningHours.IsNull() && !config.WeeklyHours.IsNull() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("weekly_hours"),
+            "Conflicting Opening Hours",
+            "Only one of opening_hours or weekly_hours may be set.",
+        )
+    }
+
+    if !config.ReopeningDate.IsNull() && !config.Status.IsUnknown() && config.Status.ValueString() != storeStatusTemporarilyClosed {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("reopening_date"),
+            "Invalid Reopening Date",
+            "reopening_date can only be set when status is temporarily_closed.",
+        )
+    }
+}
+
+func (r *storeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var state, plan storeResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if state.Status.ValueString() == storeStatusPermanentlyClosed && !plan.Status.IsUnknown() && plan.Status.ValueString() != storeStatusPermanentlyClosed {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("status"),
+            "Illegal Store Status Transition",
+            fmt.Sprintf("Store %s is permanently closed and cannot be changed to %s.", state.ID.ValueString(), plan.Status.ValueString()),
+        )
+    }
+}
+
+// transitionStatus moves a store from one status to another through the
+// store close/reopen actions.
+func (r *storeResource) transitionStatus(from string, plan storeResourceModel) error {
+    to := plan.Status.ValueString()
+    id := plan.ID.ValueString()
+
+    switch to {
+    case storeStatusActive:
+        if from == storeStatusActive {
+            return nil
+        }
+        _, err := r.client.DoRequest("POST", "/stores/"+id+"/reopen", nil)
+        return err
+    case storeStatusTemporarilyClosed, storeStatusPermanentlyClosed:
+        body := map[string]interface{}{
+            "permanent": to == storeStatusPermanentlyClosed,
+        }
+        if !plan.StatusReason.IsNull() {
+            body["reason"] = plan.StatusReason.ValueString()
+        }
+        if !plan.ReopeningDate.IsNull() {
+            body["reopening_date"] = plan.ReopeningDate.ValueString()
+        }
+        _, err := r.client.DoRequest("POST", "/stores/"+id+"/close", body)
+        return err
+    }
+    return fmt.Errorf("unknown store status %q", to)
+}
+
 func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan storeResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -196,6 +310,17 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
     if !plan.OpeningHours.IsNull() {
         requestBody["opening_hours"] = plan.OpeningHours.ValueString()
     }
+    if !plan.WeeklyHours.IsNull() {
+        weeklyHours, diags := expandWeeklyHours(ctx, plan.WeeklyHours)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        requestBody["weekly_hours"] = weeklyHours
+    }
+    if !plan.Timezone.IsNull() {
+        requestBody["timezone"] = plan.Timezone.ValueString()
+    }
     if !plan.StoreType.IsNull() {
         requestBody["store_type"] = plan.StoreType.ValueString()
     }
@@ -218,7 +343,20 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
     if id, ok := result["id"].(string); ok {
         plan.ID = types.StringValue(id)
     }
-    plan.Status = types.StringValue("active")
+
+    if plan.Status.IsUnknown() {
+        plan.Status = types.StringValue(storeStatusActive)
+        if val, ok := result["status"].(string); ok {
+            plan.Status = types.StringValue(val)
+        }
+    } else if plan.Status.ValueString() != storeStatusActive {
+        if err := r.transitionStatus(storeStatusActive, plan); err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set status of store %s: %s", plan.ID.ValueString(), err))
+            plan.Status = types.StringValue(storeStatusActive)
+            plan.StatusReason = types.StringNull()
+            plan.ReopeningDate = types.StringNull()
+        }
+    }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -246,13 +384,36 @@ func (r *storeResource) Read(ctx context.Context, req resource.ReadRequest, resp
     if val, ok := result["status"].(string); ok {
         state.Status = types.StringValue(val)
     }
+    state.StatusReason = types.StringNull()
+    state.ReopeningDate = types.StringNull()
+    if val, ok := result["status_reason"].(string); ok {
+        state.StatusReason = types.StringValue(val)
+    }
+    if val, ok := result["reopening_date"].(string); ok {
+        state.ReopeningDate = types.StringValue(val)
+    }
+    if val, ok := result["weekly_hours"]; ok {
+        weeklyHours, diags := flattenWeeklyHours(ctx, state.WeeklyHours, val)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        state.WeeklyHours = weeklyHours
+    }
+    if val, ok := result["timezone"]; ok {
+        state.Timezone = types.StringNull()
+        if timezone, ok := val.(string); ok && timezone != "" {
+            state.Timezone = types.StringValue(timezone)
+        }
+    }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-    var plan storeResourceModel
+    var plan, state storeResourceModel
     resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
     if resp.Diagnostics.HasError() {
         return
     }
@@ -268,6 +429,24 @@ func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest,
         "has_wifi":        plan.HasWifi.ValueBool(),
         "has_mobile_order": plan.HasMobileOrder.ValueBool(),
         "capacity":        plan.Capacity.ValueInt64(),
+        "opening_hours":   nil,
+        "weekly_hours":    nil,
+        "timezone":        nil,
+    }
+
+    if !plan.OpeningHours.IsNull() {
+        requestBody["opening_hours"] = plan.OpeningHours.ValueString()
+    }
+    if !plan.WeeklyHours.IsNull() {
+        weeklyHours, diags := expandWeeklyHours(ctx, plan.WeeklyHours)
+        resp.Diagnostics.Append(diags...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        requestBody["weekly_hours"] = weeklyHours
+    }
+    if !plan.Timezone.IsNull() {
+        requestBody["timezone"] = plan.Timezone.ValueString()
     }
 
     _, err := r.client.DoRequest("PUT", "/stores/"+plan.ID.ValueString(), requestBody)
@@ -276,6 +455,26 @@ func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest,
         return
     }
 
+    // Only drive close/reopen when status is set in config, so a store closed
+    // outside Terraform is not reopened by an unrelated change.
+    var configStatus types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &configStatus)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    statusChanged := !plan.Status.Equal(state.Status)
+    closureChanged := plan.Status.ValueString() != storeStatusActive &&
+        (!plan.StatusReason.Equal(state.StatusReason) || !plan.ReopeningDate.Equal(state.ReopeningDate))
+    if !configStatus.IsNull() && (statusChanged || closureChanged) {
+        if err := r.transitionStatus(state.Status.ValueString(), plan); err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change store status from %s to %s: %s", state.Status.ValueString(), plan.Status.ValueString(), err))
+            plan.Status = state.Status
+            plan.StatusReason = state.StatusReason
+            plan.ReopeningDate = state.ReopeningDate
+        }
+    }
+
     resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -286,6 +485,29 @@ func (r *storeResource) Delete(ctx context.Context, req resource.DeleteRequest,
         return
     }
 
+    if state.DeletionProtection.ValueBool() {
+        resp.Diagnostics.AddError(
+            "Store Deletion Protected",
+            fmt.Sprintf("Store %s (%s) has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", state.ID.ValueString(), state.Name.ValueString()),
+        )
+        return
+    }
+
+    switch r.client.OnDestroy {
+    case onDestroyAbandon:
+        return
+    case onDestroyArchive:
+        if state.Status.ValueString() == storeStatusPermanentlyClosed {
+            return
+        }
+        state.Status = types.StringValue(storeStatusPermanentlyClosed)
+        state.ReopeningDate = types.StringNull()
+        if err := r.transitionStatus("", state); err != nil {
+            resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive store: %s", err))
+        }
+        return
+    }
+
     _, err := r.client.DoRequest("DELETE", "/stores/"+state.ID.ValueString(), nil)
     if err != nil {
         resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store: %s", err))
@@ -294,5 +516,5 @@ func (r *storeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *storeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, "id", req.ID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
@@ -0,0 +1,283 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &storeHoursExceptionResource{}
+var _ resource.ResourceWithImportState = &storeHoursExceptionResource{}
+var _ resource.ResourceWithValidateConfig = &storeHoursExceptionResource{}
+
+type storeHoursExceptionResource struct {
+    client *StarbucksClient
+}
+
+type storeHoursExceptionResourceModel struct {
+    ID        types.String `tfsdk:"id"`
+    StoreID   types.String `tfsdk:"store_id"`
+    StartDate types.String `tfsdk:"start_date"`
+    EndDate   types.String `tfsdk:"end_date"`
+    Closed    types.Bool   `tfsdk:"closed"`
+    Open      types.String `tfsdk:"open"`
+    Close     types.String `tfsdk:"close"`
+    Reason    types.String `tfsdk:"reason"`
+}
+
+func NewStoreHoursExceptionResource() resource.Resource {
+    return &storeHoursExceptionResource{}
+}
+
+func (r *storeHoursExceptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_store_hours_exception"
+}
+
+func (r *storeHoursExceptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Overrides a store's regular hours for a date range, e.g. holidays, storms or local events.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the hours exception",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "ID of the store the exception applies to",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "start_date": schema.StringAttribute{
+                Description: "First day of the exception (YYYY-MM-DD format, inclusive)",
+                Required:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "end_date": schema.StringAttribute{
+                Description: "Last day of the exception (YYYY-MM-DD format, inclusive)",
+                Required:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "closed": schema.BoolAttribute{
+                Description: "Whether the store is closed for the whole day during the exception",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+            },
+            "open": schema.StringAttribute{
+                Description: "Override opening time in 24-hour HH:MM format. Required unless closed is true.",
+                Optional:    true,
+                Validators:  []validator.String{clockValidator{}},
+            },
+            "close": schema.StringAttribute{
+                Description: "Override closing time in 24-hour HH:MM format. Required unless closed is true.",
+                Optional:    true,
+                Validators:  []validator.String{clockValidator{}},
+            },
+            "reason": schema.StringAttribute{
+                Description: "Reason for the exception (e.g., 'Thanksgiving', 'Winter storm')",
+                Optional:    true,
+            },
+        },
+    }
+}
+
+func (r *storeHoursExceptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *storeHoursExceptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config storeHoursExceptionResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.StartDate.IsUnknown() && !config.EndDate.IsUnknown() {
+        start, startErr := time.Parse(dateLayout, config.StartDate.ValueString())
+        end, endErr := time.Parse(dateLayout, config.EndDate.ValueString())
+        if startErr == nil && endErr == nil && end.Before(start) {
+            resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid Date Range",
+                fmt.Sprintf("end_date %s is before start_date %s", config.EndDate.ValueString(), config.StartDate.ValueString()))
+        }
+    }
+
+    if config.Closed.IsUnknown() || config.Open.IsUnknown() || config.Close.IsUnknown() {
+        return
+    }
+
+    if config.Closed.ValueBool() {
+        if !config.Open.IsNull() || !config.Close.IsNull() {
+            resp.Diagnostics.AddAttributeError(path.Root("closed"), "Conflicting Hours",
+                "open and close cannot be set when closed is true.")
+        }
+        return
+    }
+
+    if config.Open.IsNull() || config.Close.IsNull() {
+        resp.Diagnostics.AddAttributeError(path.Root("open"), "Missing Hours",
+            "Both open and close must be set unless closed is true.")
+        return
+    }
+
+    open, openErr := parseClock(config.Open.ValueString())
+    closeAt, closeErr := parseClock(config.Close.ValueString())
+    if openErr == nil && closeErr == nil && open >= closeAt {
+        resp.Diagnostics.AddAttributeError(path.Root("open"), "Invalid Opening Hours",
+            fmt.Sprintf("open %s is not before close %s", config.Open.ValueString(), config.Close.ValueString()))
+    }
+}
+
+func storeHoursExceptionsPath(storeID string) string {
+    return "/stores/" + storeID + "/hours_exceptions"
+}
+
+func (m *storeHoursExceptionResourceModel) requestBody() map[string]interface{} {
+    body := map[string]interface{}{
+        "start_date": m.StartDate.ValueString(),
+        "end_date":   m.EndDate.ValueString(),
+        "closed":     m.Closed.ValueBool(),
+        "open":       nil,
+        "close":      nil,
+        "reason":     nil,
+    }
+    if !m.Open.IsNull() {
+        body["open"] = normalizeClock(m.Open.ValueString())
+    }
+    if !m.Close.IsNull() {
+        body["close"] = normalizeClock(m.Close.ValueString())
+    }
+    if !m.Reason.IsNull() {
+        body["reason"] = m.Reason.ValueString()
+    }
+    return body
+}
+
+func (r *storeHoursExceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan storeHoursExceptionResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", storeHoursExceptionsPath(plan.StoreID.ValueString()), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store hours exception: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeHoursExceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state storeHoursExceptionResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", storeHoursExceptionsPath(state.StoreID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store hours exception: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["start_date"].(string); ok {
+        state.StartDate = types.StringValue(val)
+    }
+    if val, ok := result["end_date"].(string); ok {
+        state.EndDate = types.StringValue(val)
+    }
+    if val, ok := result["closed"].(bool); ok {
+        state.Closed = types.BoolValue(val)
+    }
+    if val, ok := result["open"].(string); ok && normalizeClock(val) != normalizeClock(state.Open.ValueString()) {
+        state.Open = types.StringValue(val)
+    }
+    if val, ok := result["close"].(string); ok && normalizeClock(val) != normalizeClock(state.Close.ValueString()) {
+        state.Close = types.StringValue(val)
+    }
+    if val, ok := result["reason"].(string); ok {
+        state.Reason = types.StringValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storeHoursExceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan storeHoursExceptionResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", storeHoursExceptionsPath(plan.StoreID.ValueString())+"/"+plan.ID.ValueString(), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update store hours exception: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeHoursExceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state storeHoursExceptionResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", storeHoursExceptionsPath(state.StoreID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store hours exception: %s", err))
+        return
+    }
+}
+
+func (r *storeHoursExceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    parts := strings.Split(req.ID, "/")
+    if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+        resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form store_id/exception_id, got: %q", req.ID))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
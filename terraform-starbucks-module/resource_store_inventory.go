@@ -0,0 +1,301 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &storeInventoryResource{}
+var _ resource.ResourceWithImportState = &storeInventoryResource{}
+var _ resource.ResourceWithValidateConfig = &storeInventoryResource{}
+
+type storeInventoryResource struct {
+    client *StarbucksClient
+}
+
+type storeInventoryResourceModel struct {
+    ID      types.String `tfsdk:"id"`
+    StoreID types.String `tfsdk:"store_id"`
+    Items   types.Map    `tfsdk:"items"`
+}
+
+type storeInventoryItemModel struct {
+    Quantity     types.Int64  `tfsdk:"quantity"`
+    Unit         types.String `tfsdk:"unit"`
+    ReorderLevel types.Int64  `tfsdk:"reorder_level"`
+}
+
+var storeInventoryItemAttrTypes = map[string]attr.Type{
+    "quantity":      types.Int64Type,
+    "unit":          types.StringType,
+    "reorder_level": types.Int64Type,
+}
+
+func NewStoreInventoryResource() resource.Resource {
+    return &storeInventoryResource{}
+}
+
+func (r *storeInventoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_store_inventory"
+}
+
+func (r *storeInventoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    units := inventoryUnitNames()
+    resp.Schema = schema.Schema{
+        Description: "Manages many inventory items of one store as a single resource. Reads and writes use the store's batch inventory endpoint, sending only the SKUs that changed. Do not manage the same SKU with starbucks_inventory.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Same as store_id",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "ID of the store",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "items": schema.MapNestedAttribute{
+                Description: "Inventory items keyed by SKU. SKUs removed from the map are removed from the store.",
+                Required:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "quantity": schema.Int64Attribute{
+                            Description: "Quantity on hand, in unit",
+                            Required:    true,
+                        },
+                        "unit": schema.StringAttribute{
+                            Description: "Unit of measure: " + strings.Join(units, ", ") + ". Defaults to count.",
+                            Optional:    true,
+                            Validators:  []validator.String{oneOfValidator{values: units}},
+                        },
+                        "reorder_level": schema.Int64Attribute{
+                            Description: "Quantity at or below which the item needs reordering, in unit",
+                            Optional:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (r *storeInventoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *storeInventoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config storeInventoryResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() || config.Items.IsNull() || config.Items.IsUnknown() {
+        return
+    }
+
+    var items map[string]storeInventoryItemModel
+    resp.Diagnostics.Append(config.Items.ElementsAs(ctx, &items, false)...)
+    for sku, item := range items {
+        if strings.TrimSpace(sku) == "" {
+            resp.Diagnostics.AddAttributeError(path.Root("items"), "Invalid SKU", "SKU keys cannot be empty.")
+        }
+        if !item.Quantity.IsUnknown() && item.Quantity.ValueInt64() < 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("items").AtMapKey(sku).AtName("quantity"), "Invalid Quantity", "quantity cannot be negative.")
+        }
+        if !item.ReorderLevel.IsNull() && !item.ReorderLevel.IsUnknown() && item.ReorderLevel.ValueInt64() < 0 {
+            resp.Diagnostics.AddAttributeError(path.Root("items").AtMapKey(sku).AtName("reorder_level"), "Invalid Reorder Level", "reorder_level cannot be negative.")
+        }
+    }
+}
+
+func (m storeInventoryItemModel) equal(other storeInventoryItemModel) bool {
+    return m.Quantity.Equal(other.Quantity) && m.Unit.Equal(other.Unit) && m.ReorderLevel.Equal(other.ReorderLevel)
+}
+
+func (m storeInventoryItemModel) requestBody(sku string) map[string]interface{} {
+    body := map[string]interface{}{
+        "item_sku":      sku,
+        "quantity":      m.Quantity.ValueInt64(),
+        "unit":          "count",
+        "reorder_level": nil,
+    }
+    if !m.Unit.IsNull() {
+        body["unit"] = m.Unit.ValueString()
+    }
+    if !m.ReorderLevel.IsNull() {
+        body["reorder_level"] = m.ReorderLevel.ValueInt64()
+    }
+    return body
+}
+
+// diffStoreInventory returns the items to upsert and the SKUs to delete to
+// turn prior into planned. Both are sorted by SKU.
+func diffStoreInventory(prior, planned map[string]storeInventoryItemModel) ([]map[string]interface{}, []string) {
+    upserts := []map[string]interface{}{}
+    deletes := []string{}
+    for sku, item := range planned {
+        if old, ok := prior[sku]; ok && old.equal(item) {
+            continue
+        }
+        upserts = append(upserts, item.requestBody(sku))
+    }
+    for sku := range prior {
+        if _, ok := planned[sku]; !ok {
+            deletes = append(deletes, sku)
+        }
+    }
+    sort.Slice(upserts, func(i, j int) bool { return upserts[i]["item_sku"].(string) < upserts[j]["item_sku"].(string) })
+    sort.Strings(deletes)
+    return upserts, deletes
+}
+
+// flattenStoreInventory converts the store's inventory into the items map.
+// When managed is not nil only those SKUs are kept, so items stocked outside
+// Terraform do not show up as drift.
+func flattenStoreInventory(ctx context.Context, prior map[string]storeInventoryItemModel, inventory []map[string]interface{}, managed map[string]bool) (types.Map, diag.Diagnostics) {
+    items := map[string]storeInventoryItemModel{}
+    for _, entry := range inventory {
+        sku, _ := entry["item_sku"].(string)
+        if sku == "" || (managed != nil && !managed[sku]) {
+            continue
+        }
+        item := storeInventoryItemModel{Quantity: types.Int64Value(0), Unit: types.StringNull(), ReorderLevel: types.Int64Null()}
+        if val, ok := entry["quantity"].(float64); ok {
+            item.Quantity = types.Int64Value(int64(val))
+        }
+        if val, ok := entry["unit"].(string); ok && (val != "count" || !prior[sku].Unit.IsNull()) {
+            item.Unit = types.StringValue(val)
+        }
+        if val, ok := entry["reorder_level"].(float64); ok {
+            item.ReorderLevel = types.Int64Value(int64(val))
+        }
+        items[sku] = item
+    }
+    return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: storeInventoryItemAttrTypes}, items)
+}
+
+func (r *storeInventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan storeInventoryResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var items map[string]storeInventoryItemModel
+    resp.Diagnostics.Append(plan.Items.ElementsAs(ctx, &items, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    upserts, _ := diffStoreInventory(nil, items)
+    if err := r.client.BatchUpdateStoreInventory(plan.StoreID.ValueString(), upserts, nil); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store inventory: %s", err))
+        return
+    }
+    plan.ID = plan.StoreID
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeInventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state storeInventoryResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    inventory, err := r.client.ListInventory(InventoryFilter{StoreID: state.StoreID.ValueString()})
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store inventory: %s", err))
+        return
+    }
+
+    // An imported store has no items in state yet and adopts everything.
+    var managed map[string]bool
+    prior := map[string]storeInventoryItemModel{}
+    if !state.Items.IsNull() {
+        resp.Diagnostics.Append(state.Items.ElementsAs(ctx, &prior, false)...)
+        managed = map[string]bool{}
+        for sku := range prior {
+            managed[sku] = true
+        }
+    }
+    items, diags := flattenStoreInventory(ctx, prior, inventory, managed)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+    state.Items = items
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storeInventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan, state storeInventoryResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var planned, prior map[string]storeInventoryItemModel
+    resp.Diagnostics.Append(plan.Items.ElementsAs(ctx, &planned, false)...)
+    resp.Diagnostics.Append(state.Items.ElementsAs(ctx, &prior, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    upserts, deletes := diffStoreInventory(prior, planned)
+    if err := r.client.BatchUpdateStoreInventory(plan.StoreID.ValueString(), upserts, deletes); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update store inventory: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeInventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state storeInventoryResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var prior map[string]storeInventoryItemModel
+    resp.Diagnostics.Append(state.Items.ElementsAs(ctx, &prior, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, deletes := diffStoreInventory(prior, nil)
+    if err := r.client.BatchUpdateStoreInventory(state.StoreID.ValueString(), nil, deletes); err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store inventory: %s", err))
+        return
+    }
+}
+
+func (r *storeInventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), req.ID)...)
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &storeMenuItemResource{}
+var _ resource.ResourceWithImportState = &storeMenuItemResource{}
+var _ resource.ResourceWithValidateConfig = &storeMenuItemResource{}
+
+type storeMenuItemResource struct {
+    client *StarbucksClient
+}
+
+type storeMenuItemResourceModel struct {
+    ID            types.String  `tfsdk:"id"`
+    StoreID       types.String  `tfsdk:"store_id"`
+    MenuItemID    types.String  `tfsdk:"menu_item_id"`
+    IsAvailable   types.Bool    `tfsdk:"is_available"`
+    PriceOverride types.Float64 `tfsdk:"price_override"`
+    LaunchDate    types.String  `tfsdk:"launch_date"`
+    RetireDate    types.String  `tfsdk:"retire_date"`
+}
+
+func NewStoreMenuItemResource() resource.Resource {
+    return &storeMenuItemResource{}
+}
+
+func (r *storeMenuItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_store_menu_item"
+}
+
+func (r *storeMenuItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Links a menu item to a store with store-specific availability, regional price override and launch/retire dates.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the store menu item",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "store_id": schema.StringAttribute{
+                Description: "ID of the store",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "menu_item_id": schema.StringAttribute{
+                Description: "ID of the menu item",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "is_available": schema.BoolAttribute{
+                Description: "Whether the item is offered at this store",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(true),
+            },
+            "price_override": schema.Float64Attribute{
+                Description: "Local price (USD) replacing the menu item's price at this store",
+                Optional:    true,
+            },
+            "launch_date": schema.StringAttribute{
+                Description: "First day the item is sold at this store (YYYY-MM-DD format)",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+            "retire_date": schema.StringAttribute{
+                Description: "Day the item stops being sold at this store (YYYY-MM-DD format, exclusive)",
+                Optional:    true,
+                Validators:  []validator.String{dateValidator{}},
+            },
+        },
+    }
+}
+
+func (r *storeMenuItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (r *storeMenuItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var config storeMenuItemResourceModel
+    resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !config.PriceOverride.IsNull() && !config.PriceOverride.IsUnknown() && config.PriceOverride.ValueFloat64() < 0 {
+        resp.Diagnostics.AddAttributeError(path.Root("price_override"), "Invalid Price", "price_override cannot be negative.")
+    }
+
+    if config.LaunchDate.IsNull() || config.LaunchDate.IsUnknown() || config.RetireDate.IsNull() || config.RetireDate.IsUnknown() {
+        return
+    }
+    launch, launchErr := time.Parse(dateLayout, config.LaunchDate.ValueString())
+    retire, retireErr := time.Parse(dateLayout, config.RetireDate.ValueString())
+    if launchErr == nil && retireErr == nil && !retire.After(launch) {
+        resp.Diagnostics.AddAttributeError(path.Root("retire_date"), "Invalid Retire Date",
+            fmt.Sprintf("retire_date %s must be after launch_date %s", config.RetireDate.ValueString(), config.LaunchDate.ValueString()))
+    }
+}
+
+func storeMenuItemsPath(storeID string) string {
+    return "/stores/" + storeID + "/menu_items"
+}
+
+func (m *storeMenuItemResourceModel) requestBody() map[string]interface{} {
+    body := map[string]interface{}{
+        "menu_item_id":   m.MenuItemID.ValueString(),
+        "is_available":   m.IsAvailable.ValueBool(),
+        "price_override": nil,
+        "launch_date":    nil,
+        "retire_date":    nil,
+    }
+    if !m.PriceOverride.IsNull() {
+        body["price_override"] = m.PriceOverride.ValueFloat64()
+    }
+    if !m.LaunchDate.IsNull() {
+        body["launch_date"] = m.LaunchDate.ValueString()
+    }
+    if !m.RetireDate.IsNull() {
+        body["retire_date"] = m.RetireDate.ValueString()
+    }
+    return body
+}
+
+func (r *storeMenuItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan storeMenuItemResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", storeMenuItemsPath(plan.StoreID.ValueString()), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store menu item: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeMenuItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state storeMenuItemResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", storeMenuItemsPath(state.StoreID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store menu item: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["menu_item_id"].(string); ok {
+        state.MenuItemID = types.StringValue(val)
+    }
+    if val, ok := result["is_available"].(bool); ok {
+        state.IsAvailable = types.BoolValue(val)
+    }
+    state.PriceOverride = types.Float64Null()
+    if val, ok := result["price_override"].(float64); ok {
+        state.PriceOverride = types.Float64Value(val)
+    }
+    state.LaunchDate = types.StringNull()
+    if val, ok := result["launch_date"].(string); ok {
+        state.LaunchDate = types.StringValue(val)
+    }
+    state.RetireDate = types.StringNull()
+    if val, ok := result["retire_date"].(string); ok {
+        state.RetireDate = types.StringValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storeMenuItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan storeMenuItemResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", storeMenuItemsPath(plan.StoreID.ValueString())+"/"+plan.ID.ValueString(), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update store menu item: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storeMenuItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state storeMenuItemResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", storeMenuItemsPath(state.StoreID.ValueString())+"/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store menu item: %s", err))
+        return
+    }
+}
+
+func (r *storeMenuItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    parts := strings.Split(req.ID, "/")
+    if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+        resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form store_id/store_menu_item_id, got: %q", req.ID))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+    resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
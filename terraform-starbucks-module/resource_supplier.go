@@ -0,0 +1,217 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &supplierResource{}
+var _ resource.ResourceWithImportState = &supplierResource{}
+
+type supplierResource struct {
+    client *StarbucksClient
+}
+
+type supplierResourceModel struct {
+    ID           types.String `tfsdk:"id"`
+    Name         types.String `tfsdk:"name"`
+    ContactName  types.String `tfsdk:"contact_name"`
+    ContactEmail types.String `tfsdk:"contact_email"`
+    PhoneNumber  types.String `tfsdk:"phone_number"`
+    Address      types.String `tfsdk:"address"`
+    Active       types.Bool   `tfsdk:"active"`
+}
+
+func NewSupplierResource() resource.Resource {
+    return &supplierResource{}
+}
+
+func (r *supplierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_supplier"
+}
+
+func (r *supplierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages a supplier that products are purchased from.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Unique identifier for the supplier",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "name": schema.StringAttribute{
+                Description: "Name of the supplier",
+                Required:    true,
+            },
+            "contact_name": schema.StringAttribute{
+                Description: "Name of the account contact at the supplier",
+                Optional:    true,
+            },
+            "contact_email": schema.StringAttribute{
+                Description: "Email address orders and queries are sent to",
+                Optional:    true,
+            },
+            "phone_number": schema.StringAttribute{
+                Description: "Phone number of the supplier",
+                Optional:    true,
+            },
+            "address": schema.StringAttribute{
+                Description: "Postal address of the supplier",
+                Optional:    true,
+            },
+            "active": schema.BoolAttribute{
+                Description: "Whether new orders can be placed with the supplier. Defaults to true.",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(true),
+            },
+        },
+    }
+}
+
+func (r *supplierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+    client, ok := req.ProviderData.(*StarbucksClient)
+    if !ok {
+        resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *StarbucksClient, got: %T", req.ProviderData))
+        return
+    }
+    r.client = client
+}
+
+func (m *supplierResourceModel) requestBody() map[string]interface{} {
+    body := map[string]interface{}{
+        "name":          m.Name.ValueString(),
+        "active":        m.Active.ValueBool(),
+        "contact_name":  nil,
+        "contact_email": nil,
+        "phone_number":  nil,
+        "address":       nil,
+    }
+    if !m.ContactName.IsNull() {
+        body["contact_name"] = m.ContactName.ValueString()
+    }
+    if !m.ContactEmail.IsNull() {
+        body["contact_email"] = m.ContactEmail.ValueString()
+    }
+    if !m.PhoneNumber.IsNull() {
+        body["phone_number"] = m.PhoneNumber.ValueString()
+    }
+    if !m.Address.IsNull() {
+        body["address"] = m.Address.ValueString()
+    }
+    return body
+}
+
+func (r *supplierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan supplierResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("POST", "/suppliers", plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create supplier: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+    if id, ok := result["id"].(string); ok {
+        plan.ID = types.StringValue(id)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *supplierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state supplierResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    respBody, err := r.client.DoRequest("GET", "/suppliers/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read supplier: %s", err))
+        return
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respBody, &result); err != nil {
+        resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+        return
+    }
+
+    if val, ok := result["name"].(string); ok {
+        state.Name = types.StringValue(val)
+    }
+    if val, ok := result["contact_name"].(string); ok {
+        state.ContactName = types.StringValue(val)
+    }
+    if val, ok := result["contact_email"].(string); ok {
+        state.ContactEmail = types.StringValue(val)
+    }
+    if val, ok := result["phone_number"].(string); ok {
+        state.PhoneNumber = types.StringValue(val)
+    }
+    if val, ok := result["address"].(string); ok {
+        state.Address = types.StringValue(val)
+    }
+    if val, ok := result["active"].(bool); ok {
+        state.Active = types.BoolValue(val)
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *supplierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan supplierResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("PUT", "/suppliers/"+plan.ID.ValueString(), plan.requestBody())
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update supplier: %s", err))
+        return
+    }
+
+    resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *supplierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state supplierResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    _, err := r.client.DoRequest("DELETE", "/suppliers/"+state.ID.ValueString(), nil)
+    if err != nil {
+        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete supplier: %s", err))
+        return
+    }
+}
+
+func (r *supplierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+    resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
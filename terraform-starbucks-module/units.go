@@ -0,0 +1,52 @@
+package main
+
+import (
+    "fmt"
+    "sort"
+)
+
+// inventoryUnit describes a unit of measure as a factor of the base unit of
+// its dimension: grams for mass, millilitres for volume and items for count.
+type inventoryUnit struct {
+    dimension string
+    factor    float64
+}
+
+var inventoryUnits = map[string]inventoryUnit{
+    "g":       {dimension: "mass", factor: 1},
+    "kg":      {dimension: "mass", factor: 1000},
+    "oz":      {dimension: "mass", factor: 28.349523125},
+    "lbs":     {dimension: "mass", factor: 453.59237},
+    "ml":      {dimension: "volume", factor: 1},
+    "l":       {dimension: "volume", factor: 1000},
+    "fl_oz":   {dimension: "volume", factor: 29.5735295625},
+    "gallons": {dimension: "volume", factor: 3785.411784},
+    "count":   {dimension: "count", factor: 1},
+    "dozen":   {dimension: "count", factor: 12},
+}
+
+// inventoryUnitNames returns the supported units in alphabetical order.
+func inventoryUnitNames() []string {
+    names := make([]string, 0, len(inventoryUnits))
+    for name := range inventoryUnits {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
+}
+
+// convertUnits converts value from one unit to another of the same dimension.
+func convertUnits(value float64, from, to string) (float64, error) {
+    fromUnit, ok := inventoryUnits[from]
+    if !ok {
+        return 0, fmt.Errorf("unknown unit %q", from)
+    }
+    toUnit, ok := inventoryUnits[to]
+    if !ok {
+        return 0, fmt.Errorf("unknown unit %q", to)
+    }
+    if fromUnit.dimension != toUnit.dimension {
+        return 0, fmt.Errorf("cannot convert %s (%s) to %s (%s)", from, fromUnit.dimension, to, toUnit.dimension)
+    }
+    return value * fromUnit.factor / toUnit.factor, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "regexp"
+    "strings"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const dateLayout = "2006-01-02"
+
+type dateValidator struct{}
+
+func (v dateValidator) Description(_ context.Context) string {
+    return "value must be a date in YYYY-MM-DD format"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    if _, err := time.Parse(dateLayout, req.ConfigValue.ValueString()); err != nil {
+        resp.Diagnostics.AddAttributeError(req.Path, "Invalid Date",
+            fmt.Sprintf("%q is not a date in YYYY-MM-DD format", req.ConfigValue.ValueString()))
+    }
+}
+
+type clockValidator struct{}
+
+func (v clockValidator) Description(_ context.Context) string {
+    return "value must be a 24-hour time in HH:MM format"
+}
+
+func (v clockValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v clockValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    if _, err := parseClock(req.ConfigValue.ValueString()); err != nil {
+        resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time", err.Error())
+    }
+}
+
+type oneOfValidator struct {
+    values []string
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+    return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    for _, value := range v.values {
+        if req.ConfigValue.ValueString() == value {
+            return
+        }
+    }
+    resp.Diagnostics.AddAttributeError(req.Path, "Invalid Value",
+        fmt.Sprintf("%q is not valid, expected one of: %s", req.ConfigValue.ValueString(), strings.Join(v.values, ", ")))
+}
+
+// skuPattern matches catalog SKUs such as BEAN-PIKE-1LB: upper-case letters
+// and digits in dash-separated groups.
+var skuPattern = regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]+)*$`)
+
+type skuValidator struct{}
+
+func (v skuValidator) Description(_ context.Context) string {
+    return "value must be upper-case letters and digits in dash-separated groups"
+}
+
+func (v skuValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v skuValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    if !skuPattern.MatchString(req.ConfigValue.ValueString()) {
+        resp.Diagnostics.AddAttributeError(req.Path, "Invalid SKU",
+            fmt.Sprintf("%q is not a valid SKU, expected upper-case letters and digits in dash-separated groups, e.g. BEAN-PIKE-1LB", req.ConfigValue.ValueString()))
+    }
+}
+
+type timestampValidator struct{}
+
+func (v timestampValidator) Description(_ context.Context) string {
+    return "value must be an RFC 3339 timestamp with a timezone offset, e.g. 2024-09-01T00:00:00-07:00"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v timestampValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+        resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp",
+            fmt.Sprintf("%q is not an RFC 3339 timestamp with a timezone offset, e.g. 2024-09-01T00:00:00-07:00", req.ConfigValue.ValueString()))
+    }
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+    _ "time/tzdata"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+type weeklyHoursModel struct {
+    Open  types.String `tfsdk:"open"`
+    Close types.String `tfsdk:"close"`
+}
+
+var weeklyHoursAttrTypes = map[string]attr.Type{
+    "open":  types.StringType,
+    "close": types.StringType,
+}
+
+func weeklyHoursSchemaAttribute(description string) schema.MapNestedAttribute {
+    return schema.MapNestedAttribute{
+        Description: description + " Keyed by lowercase weekday (monday-sunday); days that are not listed are treated as closed.",
+        Optional:    true,
+        NestedObject: schema.NestedAttributeObject{
+            Attributes: map[string]schema.Attribute{
+                "open": schema.StringAttribute{
+                    Description: "Opening time in 24-hour HH:MM format",
+                    Required:    true,
+                },
+                "close": schema.StringAttribute{
+                    Description: "Closing time in 24-hour HH:MM format (24:00 for midnight)",
+                    Required:    true,
+                },
+            },
+        },
+        Validators: []validator.Map{weeklyHoursValidator{}},
+    }
+}
+
+func timezoneSchemaAttribute(description string) schema.StringAttribute {
+    return schema.StringAttribute{
+        Description: description,
+        Optional:    true,
+        Validators:  []validator.String{timezoneValidator{}},
+    }
+}
+
+// parseClock parses a 24-hour "H:MM" or "HH:MM" time into minutes since
+// midnight. "24:00" is accepted so that a day can close at midnight.
+func parseClock(value string) (int, error) {
+    parts := strings.Split(strings.TrimSpace(value), ":")
+    if len(parts) != 2 || len(parts[1]) != 2 || len(parts[0]) < 1 || len(parts[0]) > 2 {
+        return 0, fmt.Errorf("%q is not a 24-hour HH:MM time", value)
+    }
+    hours, err := strconv.Atoi(parts[0])
+    if err != nil {
+        return 0, fmt.Errorf("%q is not a 24-hour HH:MM time", value)
+    }
+    minutes, err := strconv.Atoi(parts[1])
+    if err != nil {
+        return 0, fmt.Errorf("%q is not a 24-hour HH:MM time", value)
+    }
+    if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 || (hours == 24 && minutes != 0) {
+        return 0, fmt.Errorf("%q is not a 24-hour HH:MM time", value)
+    }
+    return hours*60 + minutes, nil
+}
+
+func formatClock(minutes int) string {
+    return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// normalizeClock returns value in canonical HH:MM form, or value unchanged
+// when it cannot be parsed.
+func normalizeClock(value string) string {
+    minutes, err := parseClock(value)
+    if err != nil {
+        return value
+    }
+    return formatClock(minutes)
+}
+
+func isWeekday(day string) bool {
+    for _, d := range weekdays {
+        if d == day {
+            return true
+        }
+    }
+    return false
+}
+
+type weeklyHoursValidator struct{}
+
+func (v weeklyHoursValidator) Description(_ context.Context) string {
+    return "keys must be weekdays and each day must open before it closes"
+}
+
+func (v weeklyHoursValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v weeklyHoursValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+
+    var hours map[string]weeklyHoursModel
+    resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &hours, false)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    for day, h := range hours {
+        dayPath := req.Path.AtMapKey(day)
+        if !isWeekday(day) {
+            resp.Diagnostics.AddAttributeError(dayPath, "Invalid Weekday",
+                fmt.Sprintf("%q is not a weekday, expected one of: %s", day, strings.Join(weekdays, ", ")))
+            continue
+        }
+        if h.Open.IsUnknown() || h.Close.IsUnknown() {
+            continue
+        }
+        open, err := parseClock(h.Open.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddAttributeError(dayPath.AtName("open"), "Invalid Opening Time", err.Error())
+            continue
+        }
+        closeAt, err := parseClock(h.Close.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddAttributeError(dayPath.AtName("close"), "Invalid Closing Time", err.Error())
+            continue
+        }
+        if open >= closeAt {
+            resp.Diagnostics.AddAttributeError(dayPath, "Invalid Opening Hours",
+                fmt.Sprintf("%s opens at %s which is not before its closing time %s", day, h.Open.ValueString(), h.Close.ValueString()))
+        }
+    }
+}
+
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+    return "value must be an IANA time zone name such as America/Los_Angeles"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+    return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+    if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+        return
+    }
+    if _, err := time.LoadLocation(req.ConfigValue.ValueString()); err != nil || req.ConfigValue.ValueString() == "" {
+        resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone",
+            fmt.Sprintf("%q is not an IANA time zone name", req.ConfigValue.ValueString()))
+    }
+}
+
+// expandWeeklyHours converts weekly_hours into the API representation, a map
+// of weekday to normalised open/close times.
+func expandWeeklyHours(ctx context.Context, value types.Map) (map[string]interface{}, diag.Diagnostics) {
+    var hours map[string]weeklyHoursModel
+    diags := value.ElementsAs(ctx, &hours, false)
+    if diags.HasError() {
+        return nil, diags
+    }
+
+    result := make(map[string]interface{}, len(hours))
+    for day, h := range hours {
+        result[day] = map[string]interface{}{
+            "open":  normalizeClock(h.Open.ValueString()),
+            "close": normalizeClock(h.Close.ValueString()),
+        }
+    }
+    return result, diags
+}
+
+// flattenWeeklyHours converts the API representation back into weekly_hours.
+// Days whose times only differ from prior in formatting (e.g. 6:00 vs 06:00)
+// keep the prior value so that refreshes do not produce spurious diffs.
+func flattenWeeklyHours(ctx context.Context, prior types.Map, raw interface{}) (types.Map, diag.Diagnostics) {
+    elemType := types.ObjectType{AttrTypes: weeklyHoursAttrTypes}
+
+    apiHours, ok := raw.(map[string]interface{})
+    if !ok {
+        return prior, nil
+    }
+
+    var priorHours map[string]weeklyHoursModel
+    if !prior.IsNull() && !prior.IsUnknown() {
+        if diags := prior.ElementsAs(ctx, &priorHours, false); diags.HasError() {
+            return prior, diags
+        }
+    }
+
+    hours := make(map[string]weeklyHoursModel, len(apiHours))
+    for day, v := range apiHours {
+        entry, ok := v.(map[string]interface{})
+        if !ok {
+            continue
+        }
+        open, _ := entry["open"].(string)
+        closeAt, _ := entry["close"].(string)
+
+        if p, ok := priorHours[day]; ok &&
+            normalizeClock(p.Open.ValueString()) == normalizeClock(open) &&
+            normalizeClock(p.Close.ValueString()) == normalizeClock(closeAt) {
+            hours[day] = p
+            continue
+        }
+        hours[day] = weeklyHoursModel{
+            Open:  types.StringValue(normalizeClock(open)),
+            Close: types.StringValue(normalizeClock(closeAt)),
+        }
+    }
+
+    if len(hours) == 0 && prior.IsNull() {
+        return prior, nil
+    }
+    return types.MapValueFrom(ctx, elemType, hours)
+}